@@ -0,0 +1,70 @@
+package addwidget
+
+import (
+	"fmt"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/app"
+)
+
+// FromYAML parses a YAML snippet containing one or more module configuration blocks
+// (in the same shape as they'd appear under wtf.mods in a config file), validates each
+// against the known module types, and merges the valid ones into globalConfig under
+// wtf.mods. If any module fails validation, globalConfig is left untouched and an error
+// describing the problem is returned
+func FromYAML(snippet []byte, globalConfig *config.Config) error {
+	parsed, err := config.ParseYamlBytes(snippet)
+	if err != nil {
+		return fmt.Errorf("could not parse config snippet: %v", err)
+	}
+
+	modules, ok := parsed.Root.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("config snippet must be a map of module name to module settings")
+	}
+
+	for name, moduleConfig := range modules {
+		if err := validate(name, moduleConfig); err != nil {
+			return err
+		}
+	}
+
+	for name, moduleConfig := range modules {
+		globalConfig.Set("wtf.mods."+name, moduleConfig)
+	}
+
+	return nil
+}
+
+// validate checks that name/moduleConfig describes a known module type by building the
+// widget it would produce and checking it didn't fall through to the "Unknown" module
+func validate(name string, moduleConfig interface{}) error {
+	settings, ok := moduleConfig.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("module %q: settings must be a map", name)
+	}
+
+	settings["enabled"] = true
+
+	validationConfig := &config.Config{
+		Root: map[string]interface{}{
+			"wtf": map[string]interface{}{
+				"mods": map[string]interface{}{
+					name: settings,
+				},
+			},
+		},
+	}
+
+	widget := app.MakeWidget(nil, nil, name, validationConfig)
+	if widget != nil && widget.CommonSettings().Title != "Unknown" {
+		return nil
+	}
+
+	moduleType, _ := settings["type"].(string)
+	if moduleType == "" {
+		moduleType = name
+	}
+
+	return fmt.Errorf("module %q: unknown module type %q", name, moduleType)
+}
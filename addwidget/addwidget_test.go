@@ -0,0 +1,52 @@
+package addwidget
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	. "github.com/stretchr/testify/assert"
+)
+
+func emptyConfig() *config.Config {
+	return &config.Config{
+		Root: map[string]interface{}{
+			"wtf": map[string]interface{}{
+				"mods": map[string]interface{}{},
+			},
+		},
+	}
+}
+
+func Test_FromYAML_MergesKnownModule(t *testing.T) {
+	globalConfig := emptyConfig()
+
+	snippet := []byte(`
+myprometheus:
+  type: prometheus
+  url: http://localhost:9090
+  query: up
+`)
+
+	err := FromYAML(snippet, globalConfig)
+	Nil(t, err)
+
+	mods, _ := globalConfig.Map("wtf.mods")
+	_, ok := mods["myprometheus"]
+	True(t, ok)
+}
+
+func Test_FromYAML_RejectsUnknownModuleType(t *testing.T) {
+	globalConfig := emptyConfig()
+
+	snippet := []byte(`
+mywidget:
+  type: notarealmoduletype
+`)
+
+	err := FromYAML(snippet, globalConfig)
+	NotNil(t, err)
+
+	mods, _ := globalConfig.Map("wtf.mods")
+	_, ok := mods["mywidget"]
+	False(t, ok)
+}
@@ -0,0 +1,119 @@
+package app
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// alertedKeys tracks, per widget, which of its AlertStatuses() keys currently match that
+// widget's alertWhen rule, so a notification only fires on the transition into a match
+// rather than on every refresh while it continues to match
+var alertedKeys sync.Map
+
+// alertRule is a parsed "field == value" or "field != value" comparison, as configured by
+// a module's alertWhen setting
+type alertRule struct {
+	field  string
+	negate bool
+	value  string
+}
+
+// checkAlerts evaluates widget's alertWhen rule, if it has one, against the status values
+// it exposes via wtf.AlertStatusable. Keys that newly match trigger a desktop notification
+// and a brief border flash. Does nothing for widgets with no rule configured, or that
+// don't implement wtf.AlertStatusable.
+func checkAlerts(widget wtf.Wtfable) {
+	alertable, ok := widget.(wtf.AlertStatusable)
+	if !ok {
+		return
+	}
+
+	rule, ok := parseAlertRule(widget.CommonSettings().AlertWhen)
+	if !ok || rule.field != "status" {
+		return
+	}
+
+	previouslyMatched, _ := alertedKeys.Load(widget)
+	matched := make(map[string]bool)
+
+	for key, value := range alertable.AlertStatuses() {
+		if !rule.matches(value) {
+			continue
+		}
+
+		matched[key] = true
+
+		if already, _ := previouslyMatched.(map[string]bool); !already[key] {
+			fireAlert(widget, key, value)
+		}
+	}
+
+	alertedKeys.Store(widget, matched)
+}
+
+// parseAlertRule parses a simple "field == value" or "field != value" comparison. Returns
+// false if expr is empty or isn't in that shape.
+func parseAlertRule(expr string) (*alertRule, bool) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, false
+	}
+
+	operator := "=="
+	if strings.Contains(expr, "!=") {
+		operator = "!="
+	}
+
+	parts := strings.SplitN(expr, operator, 2)
+	if len(parts) != 2 {
+		return nil, false
+	}
+
+	return &alertRule{
+		field:  strings.TrimSpace(parts[0]),
+		negate: operator == "!=",
+		value:  strings.TrimSpace(parts[1]),
+	}, true
+}
+
+func (rule *alertRule) matches(value string) bool {
+	isEqual := value == rule.value
+
+	if rule.negate {
+		return !isEqual
+	}
+
+	return isEqual
+}
+
+// fireAlert sends a desktop notification and flashes widget's border, for key having
+// newly matched widget's alertWhen rule with the given value
+func fireAlert(widget wtf.Wtfable, key, value string) {
+	title := widget.CommonSettings().Title
+	message := fmt.Sprintf("%s: %s is now %s", title, key, value)
+
+	if err := utils.Notify(title, message); err != nil {
+		log.Printf("[%s] could not send alert notification: %v", widget.Name(), err)
+	}
+
+	flashBorder(widget)
+}
+
+// flashBorder briefly swaps widget's border to its alert color, then reverts it, as a
+// lightweight visual indicator alongside the desktop notification
+func flashBorder(widget wtf.Wtfable) {
+	view := widget.TextView()
+	originalColor := wtf.ColorFor(widget.BorderColor())
+
+	view.SetBorderColor(wtf.ColorFor("red"))
+
+	time.AfterFunc(2*time.Second, func() {
+		view.SetBorderColor(originalColor)
+	})
+}
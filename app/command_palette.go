@@ -0,0 +1,138 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const (
+	commandPalettePage      = "commandPalette"
+	commandPaletteWidth     = 60
+	commandPaletteHeight    = 12
+	commandPaletteOffscreen = -1000
+)
+
+// CommandPalette is a modal, incrementally-searchable list of every focusable widget's
+// title, used to jump focus straight to a widget without tabbing through them all
+type CommandPalette struct {
+	app          *tview.Application
+	focusTracker *FocusTracker
+	pages        *tview.Pages
+	widgets      []wtf.Wtfable
+}
+
+// NewCommandPalette creates a new CommandPalette for the given widgets
+func NewCommandPalette(app *tview.Application, pages *tview.Pages, focusTracker *FocusTracker, widgets []wtf.Wtfable) *CommandPalette {
+	return &CommandPalette{
+		app:          app,
+		focusTracker: focusTracker,
+		pages:        pages,
+		widgets:      widgets,
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Show displays the command palette modal, with focus on its search field
+func (palette *CommandPalette) Show() {
+	list := tview.NewList().ShowSecondaryText(false)
+	palette.populate(list, "")
+
+	closeFunc := func() {
+		palette.pages.RemovePage(commandPalettePage)
+		palette.focusTracker.Refocus()
+	}
+
+	list.SetSelectedFunc(func(idx int, title string, widgetName string, shortcut rune) {
+		palette.jumpTo(widgetName)
+		closeFunc()
+	})
+
+	search := tview.NewInputField().SetLabel("Jump to widget: ")
+	search.SetChangedFunc(func(text string) {
+		palette.populate(list, text)
+	})
+	search.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyDown:
+			palette.moveSelection(list, 1)
+			return nil
+		case tcell.KeyUp:
+			palette.moveSelection(list, -1)
+			return nil
+		case tcell.KeyEnter:
+			if list.GetItemCount() > 0 {
+				_, widgetName := list.GetItemText(list.GetCurrentItem())
+				palette.jumpTo(widgetName)
+			}
+			closeFunc()
+			return nil
+		case tcell.KeyEsc:
+			closeFunc()
+			return nil
+		}
+		return event
+	})
+
+	flex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(search, 1, 0, true).
+		AddItem(list, 0, 1, false)
+
+	frame := tview.NewFrame(flex)
+	frame.SetBorder(true)
+	frame.SetBorders(1, 1, 0, 0, 1, 1)
+	frame.SetRect(commandPaletteOffscreen, commandPaletteOffscreen, commandPaletteWidth, commandPaletteHeight)
+	frame.SetDrawFunc(func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+		w, h := screen.Size()
+		frame.SetRect((w/2)-(width/2), (h/2)-(height/2), width, height)
+		return x, y, width, height
+	})
+
+	palette.pages.AddPage(commandPalettePage, frame, false, true)
+	palette.app.SetFocus(search)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// populate fills list with the title of every focusable widget whose title contains
+// query, case-insensitively. An empty query matches everything. Each item carries the
+// widget's name as its (hidden) secondary text, since titles needn't be unique
+func (palette *CommandPalette) populate(list *tview.List, query string) {
+	list.Clear()
+
+	for _, widget := range palette.widgets {
+		if !widget.Focusable() {
+			continue
+		}
+
+		title := widget.CommonSettings().Title
+		if query != "" && !strings.Contains(strings.ToLower(title), strings.ToLower(query)) {
+			continue
+		}
+
+		list.AddItem(title, widget.Name(), 0, nil)
+	}
+}
+
+func (palette *CommandPalette) moveSelection(list *tview.List, delta int) {
+	count := list.GetItemCount()
+	if count == 0 {
+		return
+	}
+
+	idx := (list.GetCurrentItem() + delta + count) % count
+	list.SetCurrentItem(idx)
+}
+
+// jumpTo sets focus on the widget with the given name, a no-op if no widget matches
+func (palette *CommandPalette) jumpTo(widgetName string) {
+	for _, widget := range palette.widgets {
+		if widget.Name() == widgetName {
+			palette.focusTracker.FocusOnWidget(widget)
+			return
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+// ConfigIssue is a single problem found while validating a wtf config file
+type ConfigIssue struct {
+	Fatal      bool
+	Line       int
+	ModuleName string
+	Message    string
+}
+
+// String formats the issue the way --validate prints it to the console
+func (issue ConfigIssue) String() string {
+	severity := "WARNING"
+	if issue.Fatal {
+		severity = "ERROR"
+	}
+
+	location := issue.ModuleName
+	if issue.Line > 0 {
+		location = fmt.Sprintf("%s (line %d)", location, issue.Line)
+	}
+
+	return fmt.Sprintf("[%s] %s: %s", severity, location, issue.Message)
+}
+
+// requiredSetting describes a module setting that has to be present, in one form or
+// another, for the module to be able to do anything useful
+type requiredSetting struct {
+	// keys are the config keys that satisfy the requirement; any one of them is enough
+	keys []string
+	// envVar also satisfies the requirement, since some modules fall back to an
+	// environment variable when the matching key is left out of the config file
+	envVar  string
+	message string
+}
+
+// requiredSettings maps a module type to its hard requirements. DigitalOcean's apiKey
+// is the only one called out so far; add an entry here as other modules' requirements
+// come up
+var requiredSettings = map[string][]requiredSetting{
+	"digitalocean": {
+		{
+			keys:    []string{"apiKey", "apikey", "apiKeys"},
+			envVar:  "WTF_DIGITALOCEAN_API_KEY",
+			message: "apiKey (or apiKeys, or $WTF_DIGITALOCEAN_API_KEY) is required",
+		},
+	},
+}
+
+// ignoredKeys are config keys every module accepts that don't appear under their own
+// name in the help text (either no Settings field declares them, or cfg.Common's field
+// for them has a different name), so they'd otherwise be flagged as unrecognized
+var ignoredKeys = map[string]bool{
+	"border":   true,
+	"colors":   true,
+	"enabled":  true,
+	"position": true,
+	"type":     true,
+}
+
+// settingNamePattern matches a setting's name as rendered by utils.HelpFromInterface:
+// a blank line, then the name on its own line, then its help text on the line after
+var settingNamePattern = regexp.MustCompile(`\n\n ([a-zA-Z][a-zA-Z0-9]*)\n`)
+
+// ValidateConfig parses the given config and reports every enabled module whose type
+// doesn't exist, whose required settings are missing, or which carries a top-level key
+// this version of wtf doesn't recognize. rawConfig is the config file's raw YAML text,
+// used only to look up line numbers for the reported issues; pass "" if it's
+// unavailable and issues are still reported, just without a line number.
+//
+// Unrecognized-key detection is only as good as each module's ConfigText(): most
+// modules don't override the Base.ConfigText() implementation with their own Settings,
+// so for those, only cfg.Common's keys (title, position, colors, etc.) are checked -
+// a module-specific typo won't be caught until that module declares its own.
+func ValidateConfig(conf *config.Config, rawConfig string) []ConfigIssue {
+	issues := []ConfigIssue{}
+
+	moduleNames, _ := conf.Map("wtf.mods")
+
+	for moduleName := range moduleNames {
+		issues = append(issues, validateModule(conf, moduleName, rawConfig)...)
+	}
+
+	return issues
+}
+
+func validateModule(conf *config.Config, moduleName, rawConfig string) []ConfigIssue {
+	line := lineNumberFor(rawConfig, moduleName)
+
+	moduleConfig, _ := conf.Get("wtf.mods." + moduleName)
+	if !moduleConfig.UBool("enabled", false) {
+		return nil
+	}
+
+	widget := MakeWidget(nil, nil, moduleName, conf)
+	if widget == nil {
+		return nil
+	}
+
+	if widget.CommonSettings().Title == "Unknown" {
+		return []ConfigIssue{{
+			Fatal:      true,
+			Line:       line,
+			ModuleName: moduleName,
+			Message:    fmt.Sprintf("module type %q does not exist", widget.CommonSettings().Module.Type),
+		}}
+	}
+
+	issues := []ConfigIssue{}
+
+	for _, req := range requiredSettings[widget.CommonSettings().Module.Type] {
+		if req.satisfiedBy(moduleConfig) {
+			continue
+		}
+
+		issues = append(issues, ConfigIssue{
+			Fatal:      true,
+			Line:       line,
+			ModuleName: moduleName,
+			Message:    req.message,
+		})
+	}
+
+	for _, key := range unrecognizedKeys(moduleConfig, widget.ConfigText()) {
+		issues = append(issues, ConfigIssue{
+			Line:       line,
+			ModuleName: moduleName,
+			Message:    fmt.Sprintf("unrecognized key %q", key),
+		})
+	}
+
+	return issues
+}
+
+func (req requiredSetting) satisfiedBy(moduleConfig *config.Config) bool {
+	for _, key := range req.keys {
+		if moduleConfig.UString(key, "") != "" {
+			return true
+		}
+		if len(moduleConfig.UMap(key)) > 0 {
+			return true
+		}
+	}
+
+	return req.envVar != "" && os.Getenv(req.envVar) != ""
+}
+
+// unrecognizedKeys returns the keys present in a module's config block that don't
+// appear in its help text
+func unrecognizedKeys(moduleConfig *config.Config, helpText string) []string {
+	known := map[string]bool{}
+	for _, match := range settingNamePattern.FindAllStringSubmatch(helpText, -1) {
+		known[strings.ToLower(match[1])] = true
+	}
+
+	configMap, _ := moduleConfig.Map("")
+
+	unrecognized := []string{}
+	for key := range configMap {
+		if ignoredKeys[key] || known[strings.ToLower(key)] {
+			continue
+		}
+
+		unrecognized = append(unrecognized, key)
+	}
+
+	return unrecognized
+}
+
+// lineNumberFor returns the 1-indexed line number of the given module's key under
+// wtf.mods in the raw config text, or 0 if it can't be found
+func lineNumberFor(rawConfig, moduleName string) int {
+	needle := moduleName + ":"
+
+	for i, line := range strings.Split(rawConfig, "\n") {
+		if strings.TrimSpace(line) == needle {
+			return i + 1
+		}
+	}
+
+	return 0
+}
@@ -32,6 +32,28 @@ func NewDisplay(widgets []wtf.Wtfable, config *config.Config) *Display {
 	return &display
 }
 
+// NewSingleWidgetDisplay creates and returns a Display showing only the given widget,
+// stretched to fill the entire screen and ignoring its configured grid position
+func NewSingleWidgetDisplay(widget wtf.Wtfable, config *config.Config) *Display {
+	display := Display{
+		Grid:   tview.NewGrid(),
+		config: config,
+	}
+
+	display.Grid.SetBackgroundColor(
+		wtf.ColorFor(
+			widget.CommonSettings().Colors.WidgetTheme.Background,
+		),
+	)
+
+	display.Grid.SetColumns(0)
+	display.Grid.SetRows(0)
+	display.Grid.SetBorder(false)
+	display.Grid.AddItem(widget.TextView(), 0, 0, 1, 1, 0, 0, false)
+
+	return &display
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 func (display *Display) add(widget wtf.Wtfable) {
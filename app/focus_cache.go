@@ -0,0 +1,63 @@
+package app
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/wtfutil/wtf/cfg"
+)
+
+// focusCache is the on-disk representation of the last-focused widget, so focus can be
+// restored to it the next time the app starts
+type focusCache struct {
+	WidgetName string `json:"widgetName"`
+}
+
+// focusCacheFilePath returns the path to the on-disk last-focused-widget cache file
+func focusCacheFilePath() (string, error) {
+	configDir, err := cfg.WtfConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "cache", "focus.json"), nil
+}
+
+// saveFocusedWidget persists name as the last-focused widget. Best-effort: any error is
+// silently ignored, since losing this is a minor quality-of-life regression, not a
+// functional one.
+func saveFocusedWidget(name string) {
+	path, err := focusCacheFilePath()
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(focusCache{WidgetName: name})
+	if err != nil {
+		return
+	}
+
+	_ = ioutil.WriteFile(path, data, 0644)
+}
+
+// loadFocusedWidgetName returns the name of the last-focused widget, or "" if there's no
+// usable cache yet
+func loadFocusedWidgetName() string {
+	path, err := focusCacheFilePath()
+	if err != nil {
+		return ""
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	var cached focusCache
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return ""
+	}
+
+	return cached.WidgetName
+}
@@ -70,6 +70,27 @@ func (tracker *FocusTracker) FocusOn(char string) bool {
 	return hasFocusable
 }
 
+// FocusOnWidget sets focus directly on the given widget, used by the command palette to
+// jump straight to a widget chosen by name. Returns false if the widget isn't focusable.
+func (tracker *FocusTracker) FocusOnWidget(widget wtf.Wtfable) bool {
+	if tracker.focusState() == appBoardFocused {
+		return false
+	}
+
+	for idx, focusable := range tracker.focusables() {
+		if focusable == widget {
+			tracker.blur(tracker.Idx)
+			tracker.Idx = idx
+			tracker.focus(tracker.Idx)
+
+			tracker.IsFocused = true
+			return true
+		}
+	}
+
+	return false
+}
+
 // Next sets the focus on the next widget in the widget list. If the current widget is
 // the last widget, sets focus on the first widget.
 func (tracker *FocusTracker) Next() {
@@ -111,6 +132,33 @@ func (tracker *FocusTracker) Refocus() {
 	tracker.focus(tracker.Idx)
 }
 
+// FocusOnByName sets focus directly on the widget with the given name, used to restore
+// focus to the last-focused widget on startup. Returns false if no focusable widget has
+// that name.
+func (tracker *FocusTracker) FocusOnByName(name string) bool {
+	for idx, focusable := range tracker.focusables() {
+		if focusable.Name() == name {
+			tracker.blur(tracker.Idx)
+			tracker.Idx = idx
+			tracker.focus(tracker.Idx)
+
+			tracker.IsFocused = true
+			return true
+		}
+	}
+
+	return false
+}
+
+// FocusedWidget returns the widget that currently has focus, or nil if no widget does
+func (tracker *FocusTracker) FocusedWidget() wtf.Wtfable {
+	if !tracker.IsFocused {
+		return nil
+	}
+
+	return tracker.focusableAt(tracker.Idx)
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 // AssignHotKeys assigns an alphabetic keyboard character to each focusable
@@ -188,6 +236,8 @@ func (tracker *FocusTracker) focus(idx int) {
 		),
 	)
 	tracker.App.SetFocus(view)
+
+	saveFocusedWidget(widget.Name())
 }
 
 func (tracker *FocusTracker) focusables() []wtf.Wtfable {
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+const helpOverlayPage = "helpOverlay"
+
+// globalHelpText describes the keys handled by the app itself, rather than by any widget
+const globalHelpText = `[green]global[white]
+  ?         Show this help
+  Tab       Focus next widget
+  Shift+Tab Focus previous widget
+  Esc       Clear focus
+  Ctrl-B    Toggle compact mode
+  Ctrl-E    Collapse/expand the focused widget
+  Ctrl-K    Open the command palette
+  Ctrl-P    Pause/resume auto-refresh
+  Ctrl-R    Refresh all widgets
+`
+
+// HelpOverlay is a scrollable modal listing the global keys plus every widget's own
+// HelpText, so the user doesn't have to focus each widget in turn to discover its keys
+type HelpOverlay struct {
+	app     *tview.Application
+	pages   *tview.Pages
+	widgets []wtf.Wtfable
+}
+
+// NewHelpOverlay creates a new HelpOverlay for the given widgets
+func NewHelpOverlay(app *tview.Application, pages *tview.Pages, widgets []wtf.Wtfable) *HelpOverlay {
+	return &HelpOverlay{
+		app:     app,
+		pages:   pages,
+		widgets: widgets,
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Show displays the help overlay modal
+func (overlay *HelpOverlay) Show() {
+	closeFunc := func() {
+		overlay.pages.RemovePage(helpOverlayPage)
+		overlay.app.SetFocus(overlay.pages)
+	}
+
+	text := tview.NewTextView()
+	text.SetDynamicColors(true)
+	text.SetScrollable(true)
+	text.SetText(overlay.content())
+	text.SetBorder(true)
+	text.SetTitle(" Help ")
+	text.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeFunc()
+			return nil
+		}
+
+		if string(event.Rune()) == "q" || string(event.Rune()) == "?" {
+			closeFunc()
+			return nil
+		}
+
+		return event
+	})
+
+	overlay.pages.AddPage(helpOverlayPage, text, true, true)
+	overlay.app.SetFocus(text)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// content renders the global help text followed by each focusable widget's own HelpText
+func (overlay *HelpOverlay) content() string {
+	sections := []string{globalHelpText}
+
+	for _, widget := range overlay.widgets {
+		if !widget.Focusable() {
+			continue
+		}
+
+		helpText := strings.TrimSpace(widget.HelpText())
+		if helpText == "" {
+			continue
+		}
+
+		sections = append(sections, helpText)
+	}
+
+	return strings.Join(sections, "\n")
+}
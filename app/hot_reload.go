@@ -0,0 +1,82 @@
+package app
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// watchForSIGHUP listens for SIGHUP and, on receipt, reloads the config file and
+// recreates only the widgets whose module definitions changed, leaving the rest of
+// the dashboard (and its in-flight refresh timers) untouched
+func (wtfApp *WtfApp) watchForSIGHUP() {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+
+	for range sigs {
+		wtfApp.reloadChangedWidgets()
+	}
+}
+
+// reloadChangedWidgets re-reads the config file and diffs its module definitions against
+// the ones currently running. Widgets whose definitions are unchanged are left alone,
+// widgets whose definitions changed are recreated, and widgets that were removed from
+// the config entirely are stopped and dropped from the dashboard
+func (wtfApp *WtfApp) reloadChangedWidgets() {
+	newConfig := cfg.LoadWtfConfigFile(wtfApp.configFilePath)
+
+	oldMods, _ := wtfApp.config.Map("wtf.mods")
+	newMods, _ := newConfig.Map("wtf.mods")
+
+	widgets := []wtf.Wtfable{}
+
+	for _, widget := range wtfApp.widgets {
+		newModuleConfig, stillExists := newMods[widget.Name()]
+
+		if !stillExists {
+			log.Printf("[hot reload] %s removed from config, stopping", widget.Name())
+			widget.Stop()
+			continue
+		}
+
+		if reflect.DeepEqual(oldMods[widget.Name()], newModuleConfig) {
+			widgets = append(widgets, widget)
+			continue
+		}
+
+		log.Printf("[hot reload] %s settings changed, recreating", widget.Name())
+		widget.Stop()
+	}
+
+	for moduleName := range newMods {
+		if oldModuleConfig, existed := oldMods[moduleName]; existed && reflect.DeepEqual(oldModuleConfig, newMods[moduleName]) {
+			// Unchanged; already carried over above
+			continue
+		}
+
+		widget := MakeWidget(wtfApp.app, wtfApp.pages, moduleName, newConfig)
+		if widget == nil {
+			continue
+		}
+
+		widgets = append(widgets, widget)
+		go Schedule(widget)
+	}
+
+	wtfApp.config = newConfig
+	wtfApp.widgets = widgets
+	wtfApp.display = NewDisplay(wtfApp.widgets, wtfApp.config)
+	wtfApp.focusTracker = NewFocusTracker(wtfApp.app, wtfApp.widgets, wtfApp.config)
+	wtfApp.commandPalette = NewCommandPalette(wtfApp.app, wtfApp.pages, &wtfApp.focusTracker, wtfApp.widgets)
+	wtfApp.server.SetWidgets(wtfApp.widgets)
+
+	wtfApp.app.QueueUpdateDraw(func() {
+		wtfApp.pages.RemovePage("grid")
+		wtfApp.pages.AddPage("grid", wtfApp.display.Grid, true, true)
+	})
+}
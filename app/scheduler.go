@@ -1,38 +1,152 @@
 package app
 
 import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/wtfutil/wtf/wtf"
 )
 
+// refreshing tracks which widgets currently have a Refresh() in flight, so a widget
+// never has two fetches running at once, whether they were triggered by its own timer
+// or by a force-refresh-everything action
+var refreshing sync.Map
+
+// backoffCapMultiplier limits how far a failing widget's effective refresh interval can
+// back off to, as a multiple of its configured interval
+const backoffCapMultiplier = 8
+
 // Schedule kicks off the first refresh of a module's data and then queues the rest of the
-// data refreshes on a timer
+// data refreshes on a timer. The first refresh is delayed by a random jitter, if the
+// widget is configured with one, so that widgets sharing a refresh interval don't all
+// hit their APIs in the same instant after startup.
+//
+// If the widget implements wtf.RefreshErrorable, each consecutive failure doubles its
+// effective refresh interval, up to backoffCapMultiplier times the configured interval,
+// so a widget whose API is down doesn't keep hammering it every tick. The interval
+// resets to normal as soon as a refresh succeeds again. Widgets that don't implement
+// wtf.RefreshErrorable always refresh at their configured interval.
 func Schedule(widget wtf.Wtfable) {
-	widget.Refresh()
+	time.Sleep(jitterDelay(widget.RefreshJitter()))
 
-	interval := time.Duration(widget.RefreshInterval()) * time.Second
+	baseInterval := time.Duration(widget.RefreshInterval()) * time.Second
+	consecutiveFailures := 0
+
+	if tryRefresh(widget) {
+		consecutiveFailures = nextFailureCount(widget, consecutiveFailures)
+	}
 
-	if interval <= 0 {
+	if baseInterval <= 0 {
 		return
 	}
 
-	timer := time.NewTicker(interval)
+	interval := backoffInterval(baseInterval, consecutiveFailures)
+	setNextRefresh(widget, time.Now().Add(interval))
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-timer.C:
-			if widget.Enabled() {
-				widget.Refresh()
-			} else {
-				timer.Stop()
+			if !widget.Enabled() {
 				return
 			}
+
+			if !wtf.IsPaused() && tryRefresh(widget) {
+				consecutiveFailures = nextFailureCount(widget, consecutiveFailures)
+			}
+
+			interval = backoffInterval(baseInterval, consecutiveFailures)
+			setNextRefresh(widget, time.Now().Add(interval))
+			timer.Reset(interval)
 		case quit := <-widget.QuitChan():
 			if quit == true {
-				timer.Stop()
 				return
 			}
 		}
 	}
 }
+
+// nextFailureCount returns widget's new consecutive-failure count given its current
+// count: incremented if its last refresh errored, reset to zero otherwise. Widgets
+// that don't implement wtf.RefreshErrorable never count as failing.
+func nextFailureCount(widget wtf.Wtfable, current int) int {
+	errorable, ok := widget.(wtf.RefreshErrorable)
+	if !ok {
+		return 0
+	}
+
+	if errorable.RefreshError() != nil {
+		return current + 1
+	}
+
+	return 0
+}
+
+// backoffInterval returns the effective refresh interval for a widget with
+// consecutiveFailures failures in a row: base, doubled once per failure, up to
+// backoffCapMultiplier times base
+func backoffInterval(base time.Duration, consecutiveFailures int) time.Duration {
+	if consecutiveFailures <= 0 {
+		return base
+	}
+
+	multiplier := 1 << uint(consecutiveFailures)
+	if multiplier > backoffCapMultiplier {
+		multiplier = backoffCapMultiplier
+	}
+
+	return base * time.Duration(multiplier)
+}
+
+// setNextRefresh records when a widget's next scheduled refresh will happen, for widgets
+// that implement wtf.NextRefreshable. Widgets that don't implement it simply don't get a
+// countdown rendered.
+func setNextRefresh(widget wtf.Wtfable, at time.Time) {
+	if refreshable, ok := widget.(wtf.NextRefreshable); ok {
+		refreshable.SetNextRefresh(at)
+	}
+}
+
+// jitterDelay returns a random duration between 0 and jitterSeconds, inclusive
+func jitterDelay(jitterSeconds int) time.Duration {
+	if jitterSeconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Intn(jitterSeconds+1)) * time.Second
+}
+
+// tryRefresh calls widget.Refresh() unless that widget already has a refresh in flight,
+// in which case it does nothing. Returns true if it actually triggered a refresh.
+func tryRefresh(widget wtf.Wtfable) bool {
+	if _, inFlight := refreshing.LoadOrStore(widget, true); inFlight {
+		return false
+	}
+	defer refreshing.Delete(widget)
+
+	widget.SetRefreshing(true)
+	defer widget.SetRefreshing(false)
+
+	safeRefresh(widget)
+	checkAlerts(widget)
+
+	return true
+}
+
+// safeRefresh calls widget.Refresh(), recovering from a panic so a bug in one widget's
+// Refresh() can't take down the whole dashboard - the widget shows its error instead
+func safeRefresh(widget wtf.Wtfable) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[%s] panic during refresh: %v", widget.Name(), r)
+			widget.TextView().SetText(fmt.Sprintf("widget error: %v", r))
+		}
+	}()
+
+	widget.Refresh()
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olebedev/config"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/modules/unknown"
+)
+
+func Test_nextFailureCount(t *testing.T) {
+	moduleConfig, _ := config.ParseYaml("enabled: true\n")
+	settings := unknown.NewSettingsFromYAML("test", moduleConfig, moduleConfig)
+	widget := unknown.NewWidget(tview.NewApplication(), settings)
+
+	t.Run("widget does not implement RefreshErrorable", func(t *testing.T) {
+		if actual := nextFailureCount(widget, 3); actual != 0 {
+			t.Errorf("\nexpected: 0\n     got: %d", actual)
+		}
+	})
+}
+
+func Test_backoffInterval(t *testing.T) {
+	tests := []struct {
+		name                string
+		consecutiveFailures int
+		expected            time.Duration
+	}{
+		{"no failures", 0, 10 * time.Second},
+		{"one failure", 1, 20 * time.Second},
+		{"two failures", 2, 40 * time.Second},
+		{"three failures", 3, 80 * time.Second},
+		{"capped", 10, 80 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := backoffInterval(10*time.Second, tt.consecutiveFailures)
+
+			if actual != tt.expected {
+				t.Errorf("\nexpected: %v\n     got: %v", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -17,7 +17,11 @@ import (
 	"github.com/wtfutil/wtf/modules/devto"
 	"github.com/wtfutil/wtf/modules/digitalclock"
 	"github.com/wtfutil/wtf/modules/digitalocean"
+	"github.com/wtfutil/wtf/modules/digitalocean/balance"
+	dokubernetes "github.com/wtfutil/wtf/modules/digitalocean/kubernetes"
+	"github.com/wtfutil/wtf/modules/digitalocean/loadbalancers"
 	"github.com/wtfutil/wtf/modules/docker"
+	"github.com/wtfutil/wtf/modules/ec2"
 	"github.com/wtfutil/wtf/modules/exchangerates"
 	"github.com/wtfutil/wtf/modules/feedreader"
 	"github.com/wtfutil/wtf/modules/football"
@@ -31,11 +35,14 @@ import (
 	"github.com/wtfutil/wtf/modules/gspreadsheets"
 	"github.com/wtfutil/wtf/modules/hackernews"
 	"github.com/wtfutil/wtf/modules/hibp"
+	"github.com/wtfutil/wtf/modules/imap"
 	"github.com/wtfutil/wtf/modules/ipaddresses/ipapi"
 	"github.com/wtfutil/wtf/modules/ipaddresses/ipinfo"
 	"github.com/wtfutil/wtf/modules/jenkins"
 	"github.com/wtfutil/wtf/modules/jira"
+	"github.com/wtfutil/wtf/modules/jsonendpoint"
 	"github.com/wtfutil/wtf/modules/kubernetes"
+	"github.com/wtfutil/wtf/modules/kubernetespods"
 	"github.com/wtfutil/wtf/modules/logger"
 	"github.com/wtfutil/wtf/modules/mercurial"
 	"github.com/wtfutil/wtf/modules/nbascore"
@@ -44,6 +51,7 @@ import (
 	"github.com/wtfutil/wtf/modules/pagerduty"
 	"github.com/wtfutil/wtf/modules/pocket"
 	"github.com/wtfutil/wtf/modules/power"
+	"github.com/wtfutil/wtf/modules/prometheus"
 	"github.com/wtfutil/wtf/modules/resourceusage"
 	"github.com/wtfutil/wtf/modules/rollbar"
 	"github.com/wtfutil/wtf/modules/security"
@@ -97,6 +105,9 @@ func MakeWidget(
 	case "bargraph":
 		settings := bargraph.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = bargraph.NewWidget(app, settings)
+	case "balance":
+		settings := balance.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = balance.NewWidget(app, settings)
 	case "bittrex":
 		settings := bittrex.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = bittrex.NewWidget(app, settings)
@@ -130,9 +141,18 @@ func MakeWidget(
 	case "digitalocean":
 		settings := digitalocean.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = digitalocean.NewWidget(app, pages, settings)
+	case "dokubernetes":
+		settings := dokubernetes.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = dokubernetes.NewWidget(app, pages, settings)
+	case "doloadbalancers":
+		settings := loadbalancers.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = loadbalancers.NewWidget(app, pages, settings)
 	case "docker":
 		settings := docker.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = docker.NewWidget(app, pages, settings)
+	case "ec2":
+		settings := ec2.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = ec2.NewWidget(app, pages, settings)
 	case "feedreader":
 		settings := feedreader.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = feedreader.NewWidget(app, pages, settings)
@@ -169,6 +189,9 @@ func MakeWidget(
 	case "hibp":
 		settings := hibp.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = hibp.NewWidget(app, settings)
+	case "imap":
+		settings := imap.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = imap.NewWidget(app, settings)
 	case "ipapi":
 		settings := ipapi.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = ipapi.NewWidget(app, settings)
@@ -181,9 +204,15 @@ func MakeWidget(
 	case "jira":
 		settings := jira.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = jira.NewWidget(app, pages, settings)
+	case "jsonendpoint":
+		settings := jsonendpoint.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = jsonendpoint.NewWidget(app, settings)
 	case "kubernetes":
 		settings := kubernetes.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = kubernetes.NewWidget(app, settings)
+	case "kubernetespods":
+		settings := kubernetespods.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = kubernetespods.NewWidget(app, pages, settings)
 	case "logger":
 		settings := logger.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = logger.NewWidget(app, settings)
@@ -211,6 +240,9 @@ func MakeWidget(
 	case "pocket":
 		settings := pocket.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = pocket.NewWidget(app, pages, settings)
+	case "prometheus":
+		settings := prometheus.NewSettingsFromYAML(moduleName, moduleConfig, config)
+		widget = prometheus.NewWidget(app, settings)
 	case "resourceusage":
 		settings := resourceusage.NewSettingsFromYAML(moduleName, moduleConfig, config)
 		widget = resourceusage.NewWidget(app, settings)
@@ -1,15 +1,19 @@
 package app
 
 import (
+	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"github.com/gdamore/tcell"
 	_ "github.com/gdamore/tcell/terminfo/extended"
+	"github.com/logrusorgru/aurora"
 	"github.com/olebedev/config"
 	"github.com/radovskyb/watcher"
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/server"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/wtf"
 )
@@ -18,21 +22,28 @@ import (
 // configuration file and displayed together
 type WtfApp struct {
 	app            *tview.Application
+	commandPalette *CommandPalette
 	config         *config.Config
 	configFilePath string
 	display        *Display
 	focusTracker   FocusTracker
+	helpOverlay    *HelpOverlay
+	only           string
 	pages          *tview.Pages
+	server         *server.Server
 	validator      *ModuleValidator
 	widgets        []wtf.Wtfable
 }
 
-// NewWtfApp creates and returns an instance of WtfApp
-func NewWtfApp(app *tview.Application, config *config.Config, configFilePath string) *WtfApp {
+// NewWtfApp creates and returns an instance of WtfApp. If only is non-empty, the app
+// displays just that one module, fullscreen, ignoring the grid layout - useful for
+// developing or debugging a single module in isolation.
+func NewWtfApp(app *tview.Application, config *config.Config, configFilePath string, only string) *WtfApp {
 	wtfApp := WtfApp{
 		app:            app,
 		config:         config,
 		configFilePath: configFilePath,
+		only:           only,
 		pages:          tview.NewPages(),
 	}
 
@@ -42,10 +53,32 @@ func NewWtfApp(app *tview.Application, config *config.Config, configFilePath str
 	})
 
 	wtfApp.app.SetInputCapture(wtfApp.keyboardIntercept)
-	wtfApp.widgets = MakeWidgets(wtfApp.app, wtfApp.pages, wtfApp.config)
-	wtfApp.display = NewDisplay(wtfApp.widgets, wtfApp.config)
+
+	wtf.SetCompact(config.UBool("wtf.compact", false))
+
+	if only != "" {
+		widget := MakeWidget(wtfApp.app, wtfApp.pages, only, wtfApp.config)
+		if widget == nil {
+			fmt.Printf("\n%s %s is not a valid, enabled module in this config.\n", aurora.Red("ERROR"), aurora.Yellow(only))
+			os.Exit(1)
+		}
+
+		wtfApp.widgets = []wtf.Wtfable{widget}
+		wtfApp.display = NewSingleWidgetDisplay(widget, wtfApp.config)
+	} else {
+		wtfApp.widgets = MakeWidgets(wtfApp.app, wtfApp.pages, wtfApp.config)
+		wtfApp.display = NewDisplay(wtfApp.widgets, wtfApp.config)
+	}
+
 	wtfApp.focusTracker = NewFocusTracker(wtfApp.app, wtfApp.widgets, wtfApp.config)
+
+	if only == "" {
+		wtfApp.focusTracker.FocusOnByName(loadFocusedWidgetName())
+	}
 	wtfApp.validator = NewModuleValidator()
+	wtfApp.server = server.NewServer(server.NewSettingsFromYAML(wtfApp.config), wtfApp.widgets)
+	wtfApp.commandPalette = NewCommandPalette(wtfApp.app, wtfApp.pages, &wtfApp.focusTracker, wtfApp.widgets)
+	wtfApp.helpOverlay = NewHelpOverlay(wtfApp.app, wtfApp.pages, wtfApp.widgets)
 
 	wtfApp.pages.AddPage("grid", wtfApp.display.Grid, true, true)
 	wtfApp.app.SetRoot(wtfApp.pages, true)
@@ -73,11 +106,18 @@ func (wtfApp *WtfApp) App() *tview.Application {
 func (wtfApp *WtfApp) Start() {
 	wtfApp.scheduleWidgets()
 	go wtfApp.watchForConfigChanges()
+	go wtfApp.watchForSIGHUP()
+	go wtfApp.tickCountdowns()
+
+	if err := wtfApp.server.Start(); err != nil {
+		log.Printf("could not start dashboard server: %v", err)
+	}
 }
 
 // Stop kills all the currently-running widgets in this app
 func (wtfApp *WtfApp) Stop() {
 	wtfApp.stopAllWidgets()
+	wtfApp.server.Stop()
 }
 
 /* -------------------- Unexported Functions -------------------- */
@@ -94,6 +134,18 @@ func (wtfApp *WtfApp) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
 	case tcell.KeyCtrlR:
 		wtfApp.refreshAllWidgets()
 		return nil
+	case tcell.KeyCtrlP:
+		wtfApp.togglePaused()
+		return nil
+	case tcell.KeyCtrlK:
+		wtfApp.commandPalette.Show()
+		return nil
+	case tcell.KeyCtrlB:
+		wtfApp.toggleCompact()
+		return nil
+	case tcell.KeyCtrlE:
+		wtfApp.toggleCollapsed()
+		return nil
 	case tcell.KeyTab:
 		wtfApp.focusTracker.Next()
 	case tcell.KeyBacktab:
@@ -103,6 +155,11 @@ func (wtfApp *WtfApp) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
 		wtfApp.focusTracker.None()
 	}
 
+	if string(event.Rune()) == "?" {
+		wtfApp.helpOverlay.Show()
+		return nil
+	}
+
 	// Checks to see if any widget has been assigned the pressed key as its focus key
 	if wtfApp.focusTracker.FocusOn(string(event.Rune())) {
 		return nil
@@ -119,10 +176,54 @@ func (wtfApp *WtfApp) keyboardIntercept(event *tcell.EventKey) *tcell.EventKey {
 	return event
 }
 
+// refreshAllWidgets force-refreshes every widget out of band of its normal refresh timer.
+// Widgets with a refresh already in flight are skipped so their fetches don't overlap.
 func (wtfApp *WtfApp) refreshAllWidgets() {
 	for _, widget := range wtfApp.widgets {
-		go widget.Refresh()
+		go tryRefresh(widget)
+	}
+}
+
+// togglePaused flips the global auto-refresh pause state. Pausing stops every widget's
+// scheduled refresh timer from firing until resumed; resuming immediately refreshes every
+// widget rather than waiting for each one's timer to catch up. Refreshes triggered by a
+// widget's own keyboard actions still work while paused, only the scheduler is affected.
+func (wtfApp *WtfApp) togglePaused() {
+	if wtf.IsPaused() {
+		wtf.SetPaused(false)
+		wtfApp.refreshAllWidgets()
+	} else {
+		wtf.SetPaused(true)
+	}
+}
+
+// toggleCompact flips the global compact-mode state, which hides widget borders and
+// shrinks titles down to a single character to reclaim space on small terminals. Borders
+// are applied immediately; an immediate refresh makes titles pick up the new state right
+// away too, instead of waiting for each widget's own refresh timer to catch up.
+func (wtfApp *WtfApp) toggleCompact() {
+	wtf.SetCompact(!wtf.IsCompact())
+
+	for _, widget := range wtfApp.widgets {
+		widget.TextView().SetBorder(widget.Bordered())
 	}
+
+	wtfApp.refreshAllWidgets()
+}
+
+// toggleCollapsed flips the collapsed state of the currently-focused widget, collapsing
+// it down to just its title or expanding it back to its full content. Does nothing if no
+// widget is focused. The grid itself has a fixed row/column layout, so a collapsed widget
+// keeps its configured grid space rather than yielding it to its neighbors - only its own
+// content shrinks to just the title line.
+func (wtfApp *WtfApp) toggleCollapsed() {
+	widget := wtfApp.focusTracker.FocusedWidget()
+	if widget == nil {
+		return
+	}
+
+	widget.ToggleCollapsed()
+	go tryRefresh(widget)
 }
 
 func (wtfApp *WtfApp) scheduleWidgets() {
@@ -131,7 +232,28 @@ func (wtfApp *WtfApp) scheduleWidgets() {
 	}
 }
 
+// tickCountdowns re-renders every widget's countdown-to-next-refresh, once a second, so
+// widgets with ShowCountdown enabled visibly count down without waiting for their next
+// actual refresh
+func (wtfApp *WtfApp) tickCountdowns() {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for _, widget := range wtfApp.widgets {
+			widget.TickCountdown()
+		}
+	}
+}
+
+// watchForConfigChanges reloads the app whenever the config file on disk changes. Remote
+// (http/https) configs aren't watchable this way, so this is a no-op for those - they're
+// only re-fetched on SIGHUP or a manual restart
 func (wtfApp *WtfApp) watchForConfigChanges() {
+	if cfg.IsRemoteConfigPath(wtfApp.configFilePath) {
+		return
+	}
+
 	watch := watcher.New()
 
 	// Notify write events
@@ -144,7 +266,7 @@ func (wtfApp *WtfApp) watchForConfigChanges() {
 				wtfApp.Stop()
 
 				config := cfg.LoadWtfConfigFile(wtfApp.configFilePath)
-				newApp := NewWtfApp(wtfApp.app, config, wtfApp.configFilePath)
+				newApp := NewWtfApp(wtfApp.app, config, wtfApp.configFilePath, wtfApp.only)
 				openUrlUtil := utils.ToStrs(config.UList("wtf.openUrlUtil", []interface{}{}))
 				utils.Init(config.UString("wtf.openFileUtil", "open"), openUrlUtil)
 
@@ -0,0 +1,73 @@
+package cfg
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// commandSecretPrefix marks a config value as coming from an external command rather
+// than being a literal, e.g. `apiKey: "!cmd: pass show do/token"`
+const commandSecretPrefix = "!cmd:"
+
+// commandSecretTimeout bounds how long a `!cmd:` value is allowed to run before startup
+// gives up on it, so a hung command can't block the whole app from launching
+const commandSecretTimeout = 5 * time.Second
+
+// expandCommandSecrets walks a parsed config tree in place, replacing any string value
+// prefixed with `!cmd:` with the trimmed stdout of running the rest of the string as a
+// shell command. A command that fails or times out is logged and left as an empty string,
+// rather than aborting startup, so the failure surfaces as a widget-level error (e.g. a
+// missing API key) instead of crashing the whole app.
+func expandCommandSecrets(node interface{}) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, val := range n {
+			n[key] = expandCommandSecretNode(val)
+		}
+	case []interface{}:
+		for i, val := range n {
+			n[i] = expandCommandSecretNode(val)
+		}
+	}
+}
+
+func expandCommandSecretNode(node interface{}) interface{} {
+	switch n := node.(type) {
+	case string:
+		return runCommandSecret(n)
+	case map[string]interface{}, []interface{}:
+		expandCommandSecrets(n)
+		return n
+	default:
+		return n
+	}
+}
+
+// runCommandSecret runs str as a shell command and returns its trimmed stdout if str is
+// prefixed with commandSecretPrefix. Any other string is returned unchanged.
+func runCommandSecret(str string) string {
+	if !strings.HasPrefix(str, commandSecretPrefix) {
+		return str
+	}
+
+	command := strings.TrimSpace(strings.TrimPrefix(str, commandSecretPrefix))
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandSecretTimeout)
+	defer cancel()
+
+	var stdout bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Stdout = &stdout
+
+	if err := cmd.Run(); err != nil {
+		log.Printf("could not load secret from command '%s': %v", command, err)
+		return ""
+	}
+
+	return strings.TrimSpace(stdout.String())
+}
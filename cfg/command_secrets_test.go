@@ -0,0 +1,37 @@
+package cfg
+
+import "testing"
+
+func Test_runCommandSecret(t *testing.T) {
+	tests := []struct {
+		name     string
+		str      string
+		expected string
+	}{
+		{
+			name:     "not a command reference",
+			str:      "plain value",
+			expected: "plain value",
+		},
+		{
+			name:     "runs the command and trims its output",
+			str:      "!cmd: echo abc123",
+			expected: "abc123",
+		},
+		{
+			name:     "failing command falls back to empty",
+			str:      "!cmd: false",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := runCommandSecret(tt.str)
+
+			if actual != tt.expected {
+				t.Errorf("\nexpected: %s\n     got: %s", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ type Sigils struct {
 		Normal   string
 		Selected string
 	}
+	Paused string
 }
 
 type Common struct {
@@ -29,11 +30,15 @@ type Common struct {
 	Sigils
 
 	Colors          ColorTheme
-	Bordered        bool   `help:"Whether or not the module should be displayed with a border." values:"true, false" optional:"true" default:"true"`
-	Enabled         bool   `help:"Whether or not this module is executed and if its data displayed onscreen." values:"true, false" optional:"true" default:"false"`
-	Focusable       bool   `help:"Whether or  not this module is focusable." values:"true, false" optional:"true" default:"false"`
-	RefreshInterval int    `help:"How often, in seconds, this module will update its data." values:"A positive integer, 0..n." optional:"true"`
-	Title           string `help:"The title string to show when displaying this module" optional:"true"`
+	AlertWhen       string            `help:"A simple comparison, e.g. \"status == off\", evaluated against this module's current status values, for modules that expose any (see each module's documentation). When it newly matches, a desktop notification is sent and the widget's border flashes." optional:"true"`
+	Bordered        bool              `help:"Whether or not the module should be displayed with a border." values:"true, false" optional:"true" default:"true"`
+	Enabled         bool              `help:"Whether or not this module is executed and if its data displayed onscreen." values:"true, false" optional:"true" default:"false"`
+	Focusable       bool              `help:"Whether or  not this module is focusable." values:"true, false" optional:"true" default:"false"`
+	Keys            map[string]string `help:"A map of default keyboard shortcut to replacement shortcut, for remapping a widget's keyboard controls." values:"A map of strings to strings." optional:"true"`
+	RefreshInterval int               `help:"How often, in seconds, this module will update its data." values:"A positive integer, 0..n." optional:"true"`
+	RefreshJitter   int               `help:"The maximum number of seconds to randomly delay this module's first refresh by, so that widgets sharing a refresh interval don't all hit their APIs at the same instant. Falls back to wtf.refreshJitter when not set." values:"A positive integer, 0..n." optional:"true"`
+	ShowCountdown   bool              `help:"Whether or not to show a countdown, in seconds, until this module's next scheduled refresh in its title." values:"true, false" optional:"true" default:"false"`
+	Title           string            `help:"The title string to show when displaying this module" optional:"true"`
 	Config          *config.Config
 
 	focusChar int `help:"Define one of the number keys as a short cut key to access the widget." optional:"true"`
@@ -56,25 +61,32 @@ func NewCommonSettingsFromModule(name, defaultTitle string, defaultFocusable boo
 		colorsConfig, _ = NewDefaultColorConfig()
 	}
 
-	// And finally create a third instance to be the final default fallback in case there are empty or nil values in
+	// A third, coarser fallback: the global `wtf.theme` block, which lets a user set one
+	// foreground/background/border/selection color for every widget at once, instead of
+	// repeating the same values across every widget's `colors:` block
+	theme := themeConfig(globalSettings)
+
+	// And finally create a fourth instance to be the final default fallback in case there are empty or nil values in
 	// the colors extracted from the config file (aka colorsConfig)
 	defaultColorTheme := NewDefaultColorTheme()
 
 	baseColors.BorderTheme.Focusable = moduleConfig.UString("colors.border.focusable", colorsConfig.UString("border.focusable", defaultColorTheme.BorderTheme.Focusable))
 	baseColors.BorderTheme.Focused = moduleConfig.UString("colors.border.focused", colorsConfig.UString("border.focused", defaultColorTheme.BorderTheme.Focused))
-	baseColors.BorderTheme.Unfocusable = moduleConfig.UString("colors.border.normal", colorsConfig.UString("border.normal", defaultColorTheme.BorderTheme.Unfocusable))
+	baseColors.BorderTheme.Unfocusable = moduleConfig.UString("colors.border.normal", colorsConfig.UString("border.normal", theme.UString("border", defaultColorTheme.BorderTheme.Unfocusable)))
 
 	baseColors.CheckboxTheme.Checked = moduleConfig.UString("colors.checked", colorsConfig.UString("checked", defaultColorTheme.CheckboxTheme.Checked))
 
 	baseColors.RowTheme.EvenForeground = moduleConfig.UString("colors.rows.even", colorsConfig.UString("rows.even", defaultColorTheme.RowTheme.EvenForeground))
 	baseColors.RowTheme.OddForeground = moduleConfig.UString("colors.rows.odd", colorsConfig.UString("rows.odd", defaultColorTheme.RowTheme.OddForeground))
+	baseColors.RowTheme.HighlightedBackground = moduleConfig.UString("colors.rows.highlighted.background", colorsConfig.UString("rows.highlighted.background", theme.UString("selection", defaultColorTheme.RowTheme.HighlightedBackground)))
+	baseColors.RowTheme.HighlightedForeground = moduleConfig.UString("colors.rows.highlighted.foreground", colorsConfig.UString("rows.highlighted.foreground", defaultColorTheme.RowTheme.HighlightedForeground))
 
-	baseColors.TextTheme.Label = moduleConfig.UString("colors.label", colorsConfig.UString("label", defaultColorTheme.TextTheme.Label))
+	baseColors.TextTheme.Label = moduleConfig.UString("colors.label", colorsConfig.UString("label", theme.UString("foreground", defaultColorTheme.TextTheme.Label)))
 	baseColors.TextTheme.Subheading = moduleConfig.UString("colors.subheading", colorsConfig.UString("subheading", defaultColorTheme.TextTheme.Subheading))
-	baseColors.TextTheme.Text = moduleConfig.UString("colors.text", colorsConfig.UString("text", defaultColorTheme.TextTheme.Text))
+	baseColors.TextTheme.Text = moduleConfig.UString("colors.text", colorsConfig.UString("text", theme.UString("foreground", defaultColorTheme.TextTheme.Text)))
 	baseColors.TextTheme.Title = moduleConfig.UString("colors.title", colorsConfig.UString("title", defaultColorTheme.TextTheme.Title))
 
-	baseColors.WidgetTheme.Background = moduleConfig.UString("colors.background", colorsConfig.UString("background", defaultColorTheme.WidgetTheme.Background))
+	baseColors.WidgetTheme.Background = moduleConfig.UString("colors.background", colorsConfig.UString("background", theme.UString("background", defaultColorTheme.WidgetTheme.Background)))
 
 	common := Common{
 		Colors: baseColors,
@@ -84,13 +96,17 @@ func NewCommonSettingsFromModule(name, defaultTitle string, defaultFocusable boo
 			Type: moduleConfig.UString("type", name),
 		},
 
-		PositionSettings: NewPositionSettingsFromYAML(name, moduleConfig),
+		PositionSettings: NewPositionSettingsFromYAML(name, moduleConfig, globalSettings),
 
+		AlertWhen:       moduleConfig.UString("alertWhen", ""),
 		Bordered:        moduleConfig.UBool("border", true),
 		Config:          moduleConfig,
 		Enabled:         moduleConfig.UBool("enabled", false),
 		Focusable:       moduleConfig.UBool("focusable", defaultFocusable),
+		Keys:            keyOverrides(moduleConfig),
 		RefreshInterval: moduleConfig.UInt("refreshInterval", 300),
+		RefreshJitter:   moduleConfig.UInt("refreshJitter", globalSettings.UInt("wtf.refreshJitter", 0)),
+		ShowCountdown:   moduleConfig.UBool("showCountdown", globalSettings.UBool("wtf.showCountdown", false)),
 		Title:           moduleConfig.UString("title", defaultTitle),
 
 		focusChar: moduleConfig.UInt("focusChar", -1),
@@ -102,10 +118,36 @@ func NewCommonSettingsFromModule(name, defaultTitle string, defaultFocusable boo
 	common.Sigils.Checkbox.Unchecked = globalSettings.UString(sigilsPath+".checkbox.unchecked", " ")
 	common.Sigils.Paging.Normal = globalSettings.UString(sigilsPath+".paging.normal", globalSettings.UString("wtf.paging.pageSigil", "*"))
 	common.Sigils.Paging.Selected = globalSettings.UString(sigilsPath+".paging.select", globalSettings.UString("wtf.paging.selectedSigil", "_"))
+	common.Sigils.Paused = globalSettings.UString(sigilsPath+".paused", "★")
 
 	return &common
 }
 
+// themeConfig returns the global `wtf.theme` config block, or an empty one if it's absent,
+// so callers can look up its keys without having to special-case a missing block
+func themeConfig(globalSettings *config.Config) *config.Config {
+	theme, err := globalSettings.Get("wtf.theme")
+	if err != nil {
+		theme, _ = config.ParseYamlBytes([]byte("{}\n"))
+	}
+
+	return theme
+}
+
+// keyOverrides builds a default-shortcut-to-replacement-shortcut map out of the module's
+// `keys` setting, so a widget's keyboard controls can be remapped from the config file
+func keyOverrides(moduleConfig *config.Config) map[string]string {
+	overrides := map[string]string{}
+
+	for defaultKey, replacement := range moduleConfig.UMap("keys") {
+		if str, ok := replacement.(string); ok {
+			overrides[defaultKey] = str
+		}
+	}
+
+	return overrides
+}
+
 /* -------------------- Exported Functions -------------------- */
 
 func (common *Common) DefaultFocusedRowColor() string {
@@ -185,3 +185,44 @@ func Test_SigilStr(t *testing.T) {
 func Test_Validations(t *testing.T) {
 	assert.Equal(t, 4, len(testCfg.Validations()))
 }
+
+func Test_NewCommonSettingsFromModule_theme(t *testing.T) {
+	themeYaml := `
+wtf:
+  theme:
+    foreground: pink
+    background: black
+    border: purple
+    selection: yellow
+`
+
+	moduleConfig, _ := config.ParseYaml(themeYaml)
+	globalSettings, _ := config.ParseYaml(themeYaml)
+
+	themedCfg := NewCommonSettingsFromModule("test", "Test Config", true, moduleConfig, globalSettings)
+
+	assert.Equal(t, "pink", themedCfg.Colors.TextTheme.Text)
+	assert.Equal(t, "pink", themedCfg.Colors.TextTheme.Label)
+	assert.Equal(t, "black", themedCfg.Colors.WidgetTheme.Background)
+	assert.Equal(t, "purple", themedCfg.Colors.BorderTheme.Unfocusable)
+	assert.Equal(t, "yellow", themedCfg.Colors.RowTheme.HighlightedBackground)
+}
+
+func Test_NewCommonSettingsFromModule_moduleColorsOverrideTheme(t *testing.T) {
+	themeYaml := `
+wtf:
+  theme:
+    foreground: pink
+`
+	moduleYaml := `
+colors:
+  text: orange
+`
+
+	moduleConfig, _ := config.ParseYaml(moduleYaml)
+	globalSettings, _ := config.ParseYaml(themeYaml)
+
+	themedCfg := NewCommonSettingsFromModule("test", "Test Config", true, moduleConfig, globalSettings)
+
+	assert.Equal(t, "orange", themedCfg.Colors.TextTheme.Text)
+}
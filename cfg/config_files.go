@@ -83,8 +83,29 @@ func WtfConfigDir() (string, error) {
 	return configDir, nil
 }
 
-// LoadWtfConfigFile loads the specified config file
+// SaveWtfConfigFile renders the given config back out to YAML and writes it to the
+// specified config file
+func SaveWtfConfigFile(filePath string, wtfConfig *config.Config) error {
+	absPath, err := expandHomeDir(filePath)
+	if err != nil {
+		return err
+	}
+
+	yamlStr, err := config.RenderYaml(wtfConfig.Root)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(absPath, []byte(yamlStr), 0600)
+}
+
+// LoadWtfConfigFile loads the specified config file. filePath may be a local path or an
+// http(s) URL, in which case the config is fetched remotely; see loadRemoteConfigFile.
 func LoadWtfConfigFile(filePath string) *config.Config {
+	if IsRemoteConfigPath(filePath) {
+		return loadRemoteConfigFile(filePath)
+	}
+
 	absPath, _ := expandHomeDir(filePath)
 
 	cfg, err := config.ParseYamlFile(absPath)
@@ -93,6 +114,14 @@ func LoadWtfConfigFile(filePath string) *config.Config {
 		os.Exit(1)
 	}
 
+	requireEnv := cfg.UBool("wtf.requireEnv", false)
+	if err := expandEnvVars(cfg.Root, requireEnv); err != nil {
+		displayWtfConfigFileLoadError(absPath, err)
+		os.Exit(1)
+	}
+
+	expandCommandSecrets(cfg.Root)
+
 	return cfg
 }
 
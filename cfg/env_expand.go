@@ -0,0 +1,72 @@
+package cfg
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// expandEnvVars walks a parsed config tree in place, expanding ${VAR} and $VAR references
+// in every string value against the process environment. When requireEnv is true, a
+// reference to an unset variable is returned as an error instead of expanding to an empty
+// string, so a misconfigured deployment fails loudly at startup rather than silently running
+// with a blank secret.
+func expandEnvVars(node interface{}, requireEnv bool) error {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, val := range n {
+			expanded, err := expandEnvNode(val, requireEnv)
+			if err != nil {
+				return err
+			}
+			n[key] = expanded
+		}
+	case []interface{}:
+		for i, val := range n {
+			expanded, err := expandEnvNode(val, requireEnv)
+			if err != nil {
+				return err
+			}
+			n[i] = expanded
+		}
+	}
+
+	return nil
+}
+
+// expandEnvNode expands node if it's a string, or recurses into it if it's a nested
+// map or list, returning the (possibly replaced) value to store back in the parent
+func expandEnvNode(node interface{}, requireEnv bool) (interface{}, error) {
+	switch n := node.(type) {
+	case string:
+		return expandEnvString(n, requireEnv)
+	case map[string]interface{}, []interface{}:
+		if err := expandEnvVars(n, requireEnv); err != nil {
+			return nil, err
+		}
+		return n, nil
+	default:
+		return n, nil
+	}
+}
+
+// expandEnvString expands ${VAR} and $VAR references in str against the process
+// environment. Unset variables expand to an empty string, unless requireEnv is true,
+// in which case they produce an error naming the missing variable(s)
+func expandEnvString(str string, requireEnv bool) (string, error) {
+	missing := []string{}
+
+	expanded := os.Expand(str, func(name string) string {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+		return value
+	})
+
+	if requireEnv && len(missing) > 0 {
+		return "", fmt.Errorf("required environment variable(s) not set: %s", strings.Join(missing, ", "))
+	}
+
+	return expanded, nil
+}
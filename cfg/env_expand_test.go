@@ -0,0 +1,96 @@
+package cfg
+
+import (
+	"os"
+	"testing"
+)
+
+func Test_expandEnvString(t *testing.T) {
+	os.Setenv("WTF_TEST_TOKEN", "abc123")
+	defer os.Unsetenv("WTF_TEST_TOKEN")
+
+	tests := []struct {
+		name        string
+		str         string
+		requireEnv  bool
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "no references",
+			str:      "plain string",
+			expected: "plain string",
+		},
+		{
+			name:     "braced reference",
+			str:      "${WTF_TEST_TOKEN}",
+			expected: "abc123",
+		},
+		{
+			name:     "bare reference",
+			str:      "$WTF_TEST_TOKEN",
+			expected: "abc123",
+		},
+		{
+			name:     "unset reference falls back to empty",
+			str:      "${WTF_TEST_MISSING}",
+			expected: "",
+		},
+		{
+			name:        "unset reference errors when required",
+			str:         "${WTF_TEST_MISSING}",
+			requireEnv:  true,
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := expandEnvString(tt.str, tt.requireEnv)
+
+			if tt.expectedErr && err == nil {
+				t.Errorf("expected an error, got none")
+			}
+
+			if !tt.expectedErr {
+				if err != nil {
+					t.Errorf("\nexpected: no error\n     got: %v", err)
+				}
+				if actual != tt.expected {
+					t.Errorf("\nexpected: %s\n     got: %s", tt.expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func Test_expandEnvVars(t *testing.T) {
+	os.Setenv("WTF_TEST_TOKEN", "abc123")
+	defer os.Unsetenv("WTF_TEST_TOKEN")
+
+	tree := map[string]interface{}{
+		"apiKey": "${WTF_TEST_TOKEN}",
+		"nested": map[string]interface{}{
+			"value": "$WTF_TEST_TOKEN",
+		},
+		"list": []interface{}{"${WTF_TEST_TOKEN}"},
+	}
+
+	if err := expandEnvVars(tree, false); err != nil {
+		t.Errorf("\nexpected: no error\n     got: %v", err)
+	}
+
+	if tree["apiKey"] != "abc123" {
+		t.Errorf("\nexpected: abc123\n     got: %v", tree["apiKey"])
+	}
+
+	nested := tree["nested"].(map[string]interface{})
+	if nested["value"] != "abc123" {
+		t.Errorf("\nexpected: abc123\n     got: %v", nested["value"])
+	}
+
+	list := tree["list"].([]interface{})
+	if list[0] != "abc123" {
+		t.Errorf("\nexpected: abc123\n     got: %v", list[0])
+	}
+}
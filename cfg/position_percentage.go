@@ -0,0 +1,47 @@
+package cfg
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/olebedev/config"
+)
+
+// positionInt resolves a position attribute (top, left, width, or height) to an integer
+// number of grid cells. The value may be a plain integer, for backward compatibility, or
+// a percentage string like "50%", which is resolved against totalCells, the grid's total
+// row or column count. If neither parses, the original integer-parse error is returned
+func positionInt(moduleConfig *config.Config, path string, totalCells int) (int, error) {
+	intVal, err := moduleConfig.Int(path)
+	if err == nil {
+		return intVal, nil
+	}
+
+	strVal, strErr := moduleConfig.String(path)
+	if strErr != nil {
+		return intVal, err
+	}
+
+	pct, ok := parsePercentage(strVal)
+	if !ok {
+		return intVal, err
+	}
+
+	return int(math.Round(pct / 100 * float64(totalCells))), nil
+}
+
+// parsePercentage parses a string like "50%" into its numeric value, 50. It returns
+// false if the string is not a percentage
+func parsePercentage(val string) (float64, bool) {
+	if !strings.HasSuffix(val, "%") {
+		return 0, false
+	}
+
+	pct, err := strconv.ParseFloat(strings.TrimSuffix(val, "%"), 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return pct, true
+}
@@ -0,0 +1,43 @@
+package cfg
+
+import (
+	"testing"
+
+	"github.com/olebedev/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParsePercentage(t *testing.T) {
+	pct, ok := parsePercentage("50%")
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 50.0, pct)
+
+	_, ok = parsePercentage("50")
+	assert.Equal(t, false, ok)
+
+	_, ok = parsePercentage("fifty%")
+	assert.Equal(t, false, ok)
+}
+
+func Test_PositionInt(t *testing.T) {
+	moduleConfig, _ := config.ParseYaml(`
+position:
+  top: 3
+  left: "50%"
+  width: bogus
+`)
+
+	top, err := positionInt(moduleConfig, "position.top", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, top)
+
+	left, err := positionInt(moduleConfig, "position.left", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, left)
+
+	_, err = positionInt(moduleConfig, "position.width", 10)
+	assert.NotNil(t, err)
+
+	_, err = positionInt(moduleConfig, "position.height", 10)
+	assert.NotNil(t, err)
+}
@@ -18,24 +18,30 @@ type PositionSettings struct {
 	Width  int
 }
 
-// NewPositionSettingsFromYAML creates and returns a new instance of cfg.Position
-func NewPositionSettingsFromYAML(moduleName string, moduleConfig *config.Config) PositionSettings {
+// NewPositionSettingsFromYAML creates and returns a new instance of cfg.Position.
+// Top, left, width, and height may each be given as a plain integer, a fixed number of
+// grid cells, for backward compatibility, or as a percentage string (e.g. "50%"), which
+// is resolved against the grid's total row or column count
+func NewPositionSettingsFromYAML(moduleName string, moduleConfig *config.Config, globalConfig *config.Config) PositionSettings {
 	var currVal int
 	var err error
 
 	validations := NewValidations()
 
+	numRows := len(globalConfig.UList("wtf.grid.rows"))
+	numCols := len(globalConfig.UList("wtf.grid.columns"))
+
 	// Parse the positional data from the config data
-	currVal, err = moduleConfig.Int(positionPath + ".top")
+	currVal, err = positionInt(moduleConfig, positionPath+".top", numRows)
 	validations.append("top", newPositionValidation("top", currVal, err))
 
-	currVal, err = moduleConfig.Int(positionPath + ".left")
+	currVal, err = positionInt(moduleConfig, positionPath+".left", numCols)
 	validations.append("left", newPositionValidation("left", currVal, err))
 
-	currVal, err = moduleConfig.Int(positionPath + ".width")
+	currVal, err = positionInt(moduleConfig, positionPath+".width", numCols)
 	validations.append("width", newPositionValidation("width", currVal, err))
 
-	currVal, err = moduleConfig.Int(positionPath + ".height")
+	currVal, err = positionInt(moduleConfig, positionPath+".height", numRows)
 	validations.append("height", newPositionValidation("height", currVal, err))
 
 	pos := PositionSettings{
@@ -1,5 +1,7 @@
 package checklist
 
+import "sort"
+
 // Checklist is a module for creating generic checklist implementations
 // See 'Todo' for an implementation example
 type Checklist struct {
@@ -101,6 +103,108 @@ func (list *Checklist) UncheckedItems() []*ChecklistItem {
 	return items
 }
 
+// HasNesting returns true if any item in the list is indented beneath another, ie. the list
+// has sub-tasks
+func (list *Checklist) HasNesting() bool {
+	for _, item := range list.Items {
+		if item.Depth() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasChildren returns true if the item at idx is immediately followed by at least one item
+// nested beneath it
+func (list *Checklist) HasChildren(idx int) bool {
+	if idx < 0 || idx+1 >= len(list.Items) {
+		return false
+	}
+
+	return list.Items[idx+1].Depth() > list.Items[idx].Depth()
+}
+
+// ChildIndexes returns the indexes of every item nested beneath the item at idx, direct or
+// indirect, stopping at the next item that isn't deeper than it
+func (list *Checklist) ChildIndexes(idx int) []int {
+	indexes := []int{}
+	if idx < 0 || idx >= len(list.Items) {
+		return indexes
+	}
+
+	parentDepth := list.Items[idx].Depth()
+	for i := idx + 1; i < len(list.Items); i++ {
+		if list.Items[i].Depth() <= parentDepth {
+			break
+		}
+		indexes = append(indexes, i)
+	}
+
+	return indexes
+}
+
+// VisibleIndexes returns the indexes of Items that should currently be rendered, in list
+// order, omitting the descendants of any collapsed parent
+func (list *Checklist) VisibleIndexes() []int {
+	visible := []int{}
+	skipBelowDepth := -1
+
+	for idx, item := range list.Items {
+		if skipBelowDepth >= 0 {
+			if item.Depth() > skipBelowDepth {
+				continue
+			}
+			skipBelowDepth = -1
+		}
+
+		visible = append(visible, idx)
+
+		if item.Collapsed && list.HasChildren(idx) {
+			skipBelowDepth = item.Depth()
+		}
+	}
+
+	return visible
+}
+
+// SetChecked sets the checked state of the item at idx and, when cascade is true, every
+// item nested beneath it too
+func (list *Checklist) SetChecked(idx int, checked bool, cascade bool) {
+	if idx < 0 || idx >= len(list.Items) {
+		return
+	}
+
+	list.Items[idx].Checked = checked
+
+	if !cascade {
+		return
+	}
+
+	for _, childIdx := range list.ChildIndexes(idx) {
+		list.Items[childIdx].Checked = checked
+	}
+}
+
+// SortByDue sorts Items by due date, soonest first. Items with no due date sort after all
+// items that have one, and otherwise keep their relative order
+func (list *Checklist) SortByDue() {
+	sort.SliceStable(list.Items, func(i, j int) bool {
+		dueI, okI := list.Items[i].DueDate()
+		dueJ, okJ := list.Items[j].DueDate()
+
+		if okI != okJ {
+			return okI
+		}
+
+		if !okI {
+			return false
+		}
+
+		return dueI.Before(dueJ)
+	})
+}
+
 // Unselect removes the current select such that no item is selected
 func (list *Checklist) Unselect() {
 	list.selected = -1
@@ -1,6 +1,16 @@
 package checklist
 
-import ()
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// dueDateFormat is the layout used by the `@due:` annotation embedded in a checklist
+// item's text, e.g. "Pay rent @due:2024-01-01"
+const dueDateFormat = "2006-01-02"
+
+var dueDatePattern = regexp.MustCompile(`@due:(\d{4}-\d{2}-\d{2})`)
 
 // ChecklistItem is a module for creating generic checklist implementations
 // See 'Todo' for an implementation example
@@ -9,6 +19,7 @@ type ChecklistItem struct {
 	CheckedIcon   string
 	Text          string
 	UncheckedIcon string
+	Collapsed     bool
 }
 
 func NewChecklistItem(checked bool, text string, checkedIcon, uncheckedIcon string) *ChecklistItem {
@@ -39,8 +50,90 @@ func (item *ChecklistItem) Toggle() {
 	item.Checked = !item.Checked
 }
 
+// Depth returns how many levels of indentation prefix the item's text, treating either a
+// leading tab or a leading pair of spaces as one level. Sub-tasks are created by indenting
+// an item's text beneath its parent in the backing file
+func (item *ChecklistItem) Depth() int {
+	text := item.Text
+	depth := 0
+
+	for {
+		if strings.HasPrefix(text, "\t") {
+			text = text[1:]
+			depth++
+			continue
+		}
+
+		if strings.HasPrefix(text, "  ") {
+			text = text[2:]
+			depth++
+			continue
+		}
+
+		break
+	}
+
+	return depth
+}
+
+// DisplayText returns the item's text with its leading indentation removed
+func (item *ChecklistItem) DisplayText() string {
+	text := item.Text
+
+	for i := 0; i < item.Depth(); i++ {
+		text = strings.TrimPrefix(text, "\t")
+		text = strings.TrimPrefix(text, "  ")
+	}
+
+	return text
+}
+
+// DueDate extracts the `@due:YYYY-MM-DD` annotation from the item's text, if present. Text
+// is left untouched -- the annotation is parsed out on read rather than stored separately,
+// so persisting the item back to disk can't lose or reformat it
+func (item *ChecklistItem) DueDate() (time.Time, bool) {
+	matches := dueDatePattern.FindStringSubmatch(item.Text)
+	if matches == nil {
+		return time.Time{}, false
+	}
+
+	due, err := time.Parse(dueDateFormat, matches[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return due, true
+}
+
+// IsOverdue returns true if the item has a due date that has already passed
+func (item *ChecklistItem) IsOverdue() bool {
+	due, ok := item.DueDate()
+	if !ok {
+		return false
+	}
+
+	return due.Before(today())
+}
+
+// IsDueToday returns true if the item's due date is today
+func (item *ChecklistItem) IsDueToday() bool {
+	due, ok := item.DueDate()
+	if !ok {
+		return false
+	}
+
+	return due.Equal(today())
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
+// today returns the local calendar date as midnight UTC, the same form DueDate parses
+// `@due:` dates into, so the two compare by date regardless of the local time zone
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 func (item *ChecklistItem) ensureItemIcons() {
 	if item.CheckedIcon == "" {
 		item.CheckedIcon = "x"
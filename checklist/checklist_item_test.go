@@ -2,6 +2,7 @@ package checklist
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/stretchr/testify/assert"
 )
@@ -34,3 +35,79 @@ func Test_Toggle(t *testing.T) {
 	item.Toggle()
 	Equal(t, false, item.Checked)
 }
+
+func Test_Depth(t *testing.T) {
+	Equal(t, 0, NewChecklistItem(false, "top level", "", "").Depth())
+	Equal(t, 1, NewChecklistItem(false, "  one level", "", "").Depth())
+	Equal(t, 2, NewChecklistItem(false, "    two levels", "", "").Depth())
+	Equal(t, 1, NewChecklistItem(false, "\tone tab", "", "").Depth())
+}
+
+func Test_DisplayText(t *testing.T) {
+	Equal(t, "task", NewChecklistItem(false, "  task", "", "").DisplayText())
+	Equal(t, "task", NewChecklistItem(false, "task", "", "").DisplayText())
+}
+
+func Test_DueDate(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		expectedOk  bool
+		expectedDue time.Time
+	}{
+		{
+			name:       "with no due date",
+			text:       "plain task",
+			expectedOk: false,
+		},
+		{
+			name:        "with a due date",
+			text:        "task @due:2024-01-01",
+			expectedOk:  true,
+			expectedDue: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "with a malformed due date",
+			text:       "task @due:2024-99-99",
+			expectedOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := NewChecklistItem(false, tt.text, "", "")
+
+			due, ok := item.DueDate()
+
+			Equal(t, tt.expectedOk, ok)
+			if tt.expectedOk {
+				Equal(t, tt.expectedDue, due)
+			}
+		})
+	}
+}
+
+func Test_IsOverdue(t *testing.T) {
+	past := time.Now().AddDate(0, 0, -1).Format(dueDateFormat)
+	future := time.Now().AddDate(0, 0, 1).Format(dueDateFormat)
+
+	overdue := NewChecklistItem(false, "task @due:"+past, "", "")
+	Equal(t, true, overdue.IsOverdue())
+
+	notOverdue := NewChecklistItem(false, "task @due:"+future, "", "")
+	Equal(t, false, notOverdue.IsOverdue())
+
+	noDueDate := NewChecklistItem(false, "task", "", "")
+	Equal(t, false, noDueDate.IsOverdue())
+}
+
+func Test_IsDueToday(t *testing.T) {
+	today := time.Now().Format(dueDateFormat)
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(dueDateFormat)
+
+	dueToday := NewChecklistItem(false, "task @due:"+today, "", "")
+	Equal(t, true, dueToday.IsDueToday())
+
+	dueTomorrow := NewChecklistItem(false, "task @due:"+tomorrow, "", "")
+	Equal(t, false, dueTomorrow.IsDueToday())
+}
@@ -323,6 +323,75 @@ func Test_Unselect(t *testing.T) {
 	assert.Equal(t, -1, cl.selected)
 }
 
+func Test_HasNesting(t *testing.T) {
+	flat := NewChecklist("o", "-")
+	flat.Add(false, "top level")
+	assert.Equal(t, false, flat.HasNesting())
+
+	nested := NewChecklist("o", "-")
+	nested.Items = []*ChecklistItem{
+		NewChecklistItem(false, "parent", "", ""),
+		NewChecklistItem(false, "  child", "", ""),
+	}
+	assert.Equal(t, true, nested.HasNesting())
+}
+
+func Test_HasChildren_And_ChildIndexes(t *testing.T) {
+	cl := NewChecklist("o", "-")
+	cl.Items = []*ChecklistItem{
+		NewChecklistItem(false, "parent", "", ""),
+		NewChecklistItem(false, "  child 1", "", ""),
+		NewChecklistItem(false, "  child 2", "", ""),
+		NewChecklistItem(false, "sibling", "", ""),
+	}
+
+	assert.Equal(t, true, cl.HasChildren(0))
+	assert.Equal(t, []int{1, 2}, cl.ChildIndexes(0))
+
+	assert.Equal(t, false, cl.HasChildren(3))
+	assert.Equal(t, []int{}, cl.ChildIndexes(3))
+}
+
+func Test_VisibleIndexes(t *testing.T) {
+	cl := NewChecklist("o", "-")
+	cl.Items = []*ChecklistItem{
+		NewChecklistItem(false, "parent", "", ""),
+		NewChecklistItem(false, "  child", "", ""),
+		NewChecklistItem(false, "sibling", "", ""),
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, cl.VisibleIndexes())
+
+	cl.Items[0].Collapsed = true
+	assert.Equal(t, []int{0, 2}, cl.VisibleIndexes())
+}
+
+func Test_SetChecked(t *testing.T) {
+	cl := NewChecklist("o", "-")
+	cl.Items = []*ChecklistItem{
+		NewChecklistItem(false, "parent", "", ""),
+		NewChecklistItem(false, "  child", "", ""),
+	}
+
+	cl.SetChecked(0, true, true)
+
+	assert.Equal(t, true, cl.Items[0].Checked)
+	assert.Equal(t, true, cl.Items[1].Checked)
+}
+
+func Test_SortByDue(t *testing.T) {
+	cl := NewChecklist("o", "-")
+	cl.Add(false, "no due date")
+	cl.Add(false, "due later @due:2030-01-01")
+	cl.Add(false, "due sooner @due:2020-01-01")
+
+	cl.SortByDue()
+
+	assert.Equal(t, "due sooner @due:2020-01-01", cl.Items[0].Text)
+	assert.Equal(t, "due later @due:2030-01-01", cl.Items[1].Text)
+	assert.Equal(t, "no due date", cl.Items[2].Text)
+}
+
 /* -------------------- Sort Interface -------------------- */
 
 func Test_Len(t *testing.T) {
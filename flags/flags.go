@@ -12,10 +12,14 @@ import (
 
 // Flags is the container for command line flag data
 type Flags struct {
-	Config  string `short:"c" long:"config" optional:"yes" description:"Path to config file"`
-	Module  string `short:"m" long:"module" optional:"yes" description:"Display info about a specific module, i.e.: 'wtfutil -m=todo'"`
-	Profile bool   `short:"p" long:"profile" optional:"yes" description:"Profile application memory usage"`
-	Version bool   `short:"v" long:"version" description:"Show version info"`
+	AddWidget bool   `long:"add-widget" optional:"yes" description:"Read a module config snippet (YAML) from stdin and merge it into the config file under wtf.mods"`
+	Config    string `short:"c" long:"config" optional:"yes" description:"Path to config file, or an http(s) URL to fetch it from"`
+	LogLevel  string `long:"log-level" optional:"yes" description:"Minimum severity to write to the log file: error, warn, info, or debug. Overrides wtf.logLevel."`
+	Module    string `short:"m" long:"module" optional:"yes" description:"Display info about a specific module, i.e.: 'wtfutil -m=todo'"`
+	Only      string `long:"only" optional:"yes" description:"Display only the named module, fullscreen, ignoring the grid layout, i.e.: 'wtfutil --only todo'"`
+	Profile   bool   `short:"p" long:"profile" optional:"yes" description:"Profile application memory usage"`
+	Validate  bool   `long:"validate" optional:"yes" description:"Validate the config file, report any errors and warnings, and exit"`
+	Version   bool   `short:"v" long:"version" description:"Show version info"`
 
 	hasCustom bool
 }
@@ -47,16 +51,36 @@ func (flags *Flags) RenderIf(version string, config *config.Config) {
 	}
 }
 
+// HasAddWidget returns TRUE if the --add-widget flag was passed in, FALSE if it was not
+func (flags *Flags) HasAddWidget() bool {
+	return flags.AddWidget == true
+}
+
 // HasCustomConfig returns TRUE if a config path was passed in, FALSE if one was not
 func (flags *Flags) HasCustomConfig() bool {
 	return flags.hasCustom
 }
 
+// HasLogLevel returns TRUE if a log level was passed in via --log-level, FALSE if one was not
+func (flags *Flags) HasLogLevel() bool {
+	return len(flags.LogLevel) > 0
+}
+
 // HasModule returns TRUE if a module name was passed in, FALSE if one was not
 func (flags *Flags) HasModule() bool {
 	return len(flags.Module) > 0
 }
 
+// HasValidate returns TRUE if the --validate flag was passed in, FALSE if it was not
+func (flags *Flags) HasValidate() bool {
+	return flags.Validate == true
+}
+
+// HasOnly returns TRUE if a module name was passed in via --only, FALSE if one was not
+func (flags *Flags) HasOnly() bool {
+	return len(flags.Only) > 0
+}
+
 // HasVersion returns TRUE if the version flag was passed in, FALSE if it was not
 func (flags *Flags) HasVersion() bool {
 	return flags.Version == true
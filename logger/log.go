@@ -1,33 +1,118 @@
 package logger
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
-/* -------------------- Exported Functions -------------------- */
+// Level is the severity of a log message. Levels are ordered from least to most
+// verbose; logging configured at a given level also logs every level above it
+// (i.e. LevelWarn also logs LevelError).
+type Level int
 
-func Log(msg string) {
-	if LogFileMissing() {
-		return
+const (
+	LevelError Level = iota
+	LevelWarn
+	LevelInfo
+	LevelDebug
+)
+
+// level and filePath are set once at startup via Init and read by every log call
+// made after that
+var (
+	level    = LevelInfo
+	filePath = defaultLogFilePath()
+)
+
+// Init configures the logging subsystem's file path and minimum level. It should be
+// called once at startup, before any widgets start logging. An empty path leaves the
+// default (~/.config/wtf/log.txt) in place.
+func Init(path, levelName string) {
+	if path != "" {
+		filePath = path
 	}
 
-	f, err := os.OpenFile(LogFilePath(), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
-	if err != nil {
-		log.Fatalf("error opening file: %v", err)
+	level = ParseLevel(levelName)
+}
+
+// ParseLevel returns the Level named by levelName, defaulting to LevelInfo for an
+// empty or unrecognized name
+func ParseLevel(levelName string) Level {
+	switch strings.ToLower(levelName) {
+	case "error":
+		return LevelError
+	case "warn", "warning":
+		return LevelWarn
+	case "debug":
+		return LevelDebug
+	default:
+		return LevelInfo
 	}
-	defer f.Close()
+}
 
-	log.SetOutput(f)
-	log.Println(msg)
+// String returns the level's name as it appears in the log file
+func (lvl Level) String() string {
+	switch lvl {
+	case LevelError:
+		return "ERROR"
+	case LevelWarn:
+		return "WARN"
+	case LevelDebug:
+		return "DEBUG"
+	default:
+		return "INFO"
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Log writes msg to the log file at LevelInfo. Prefer Error/Warn/Info/Debug below,
+// which tag the line with its severity; this is kept around because it's already
+// called from several modules.
+func Log(msg string) {
+	writeAt(LevelInfo, msg)
+}
+
+// Error logs a formatted message at LevelError. Always written, regardless of the
+// configured level.
+func Error(format string, args ...interface{}) {
+	writeAt(LevelError, fmt.Sprintf(format, args...))
+}
+
+// Warn logs a formatted message at LevelWarn
+func Warn(format string, args ...interface{}) {
+	writeAt(LevelWarn, fmt.Sprintf(format, args...))
 }
 
+// Info logs a formatted message at LevelInfo
+func Info(format string, args ...interface{}) {
+	writeAt(LevelInfo, fmt.Sprintf(format, args...))
+}
+
+// Debug logs a formatted message at LevelDebug. Use this for noisy, request-by-request
+// detail (e.g. "fetching page %d of droplets") that's only useful while actively
+// debugging an API issue. Never pass API keys, tokens, or other secrets to this or any
+// other logging function - the log file is plaintext.
+func Debug(format string, args ...interface{}) {
+	writeAt(LevelDebug, fmt.Sprintf(format, args...))
+}
+
+// LogFileMissing returns TRUE if no log file path is available, FALSE if one is
 func LogFileMissing() bool {
 	return LogFilePath() == ""
 }
 
+// LogFilePath returns the path that log lines are written to
 func LogFilePath() string {
+	return filePath
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func defaultLogFilePath() string {
 	dir, err := os.UserHomeDir()
 	if err != nil {
 		return ""
@@ -35,3 +120,18 @@ func LogFilePath() string {
 
 	return filepath.Join(dir, ".config", "wtf", "log.txt")
 }
+
+func writeAt(msgLevel Level, msg string) {
+	if msgLevel > level || LogFileMissing() {
+		return
+	}
+
+	f, err := os.OpenFile(LogFilePath(), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		log.Fatalf("error opening file: %v", err)
+	}
+	defer f.Close()
+
+	log.SetOutput(f)
+	log.Printf("[%s] %s", msgLevel, msg)
+}
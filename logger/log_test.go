@@ -0,0 +1,62 @@
+package logger
+
+import "testing"
+
+func Test_ParseLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Level
+	}{
+		{"error", "error", LevelError},
+		{"warn", "warn", LevelWarn},
+		{"warning", "warning", LevelWarn},
+		{"info", "info", LevelInfo},
+		{"debug", "debug", LevelDebug},
+		{"uppercase", "DEBUG", LevelDebug},
+		{"empty defaults to info", "", LevelInfo},
+		{"unrecognized defaults to info", "chatty", LevelInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := ParseLevel(tt.input)
+
+			if actual != tt.expected {
+				t.Errorf("\nexpected: %v\n     got: %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_Level_String(t *testing.T) {
+	tests := []struct {
+		level    Level
+		expected string
+	}{
+		{LevelError, "ERROR"},
+		{LevelWarn, "WARN"},
+		{LevelInfo, "INFO"},
+		{LevelDebug, "DEBUG"},
+	}
+
+	for _, tt := range tests {
+		if actual := tt.level.String(); actual != tt.expected {
+			t.Errorf("\nexpected: %s\n     got: %s", tt.expected, actual)
+		}
+	}
+}
+
+func Test_Init(t *testing.T) {
+	defer Init("", "info")
+
+	Init("/tmp/wtf-test-log.txt", "debug")
+
+	if LogFilePath() != "/tmp/wtf-test-log.txt" {
+		t.Errorf("\nexpected: /tmp/wtf-test-log.txt\n     got: %s", LogFilePath())
+	}
+
+	if level != LevelDebug {
+		t.Errorf("\nexpected: %v\n     got: %v", LevelDebug, level)
+	}
+}
@@ -7,6 +7,7 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 
@@ -15,9 +16,11 @@ import (
 	"github.com/pkg/profile"
 
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/addwidget"
 	"github.com/wtfutil/wtf/app"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/flags"
+	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/utils"
 )
 
@@ -31,6 +34,69 @@ var (
 
 /* -------------------- Functions -------------------- */
 
+// addWidgetFromStdin reads a module config snippet from stdin, validates and merges it
+// into config, and writes the result back to the config file at filePath
+func addWidgetFromStdin(config *config.Config, filePath string) {
+	snippet, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		fmt.Printf("\n%s Failed to read config snippet from stdin: %v\n", aurora.Red("ERROR"), err)
+		os.Exit(1)
+	}
+
+	if err := addwidget.FromYAML(snippet, config); err != nil {
+		fmt.Printf("\n%s %v\n", aurora.Red("ERROR"), err)
+		os.Exit(1)
+	}
+
+	if err := cfg.SaveWtfConfigFile(filePath, config); err != nil {
+		fmt.Printf("\n%s Failed to save config file: %v\n", aurora.Red("ERROR"), err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Widget added.")
+	os.Exit(0)
+}
+
+// validateConfig checks the config file for unknown module types, missing required
+// settings, and unrecognized keys, prints what it finds, then exits non-zero if any
+// of it is fatal
+func validateConfig(config *config.Config, filePath string) {
+	rawConfig, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		fmt.Printf("\n%s Failed to read config file: %v\n", aurora.Red("ERROR"), err)
+		os.Exit(1)
+	}
+
+	issues := app.ValidateConfig(config, string(rawConfig))
+	if len(issues) == 0 {
+		fmt.Println("Config is valid.")
+		os.Exit(0)
+	}
+
+	fatal := false
+	for _, issue := range issues {
+		fmt.Println(issue)
+		fatal = fatal || issue.Fatal
+	}
+
+	if fatal {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// initLogger configures the logging subsystem from the config file, overridden by
+// --log-level if it was passed in
+func initLogger(config *config.Config, flags *flags.Flags) {
+	logLevel := config.UString("wtf.logLevel", "info")
+	if flags.HasLogLevel() {
+		logLevel = flags.LogLevel
+	}
+
+	logger.Init(config.UString("wtf.logfile", ""), logLevel)
+}
+
 func setTerm(config *config.Config) {
 	term := config.UString("wtf.term", os.Getenv("TERM"))
 	err := os.Setenv("TERM", term)
@@ -56,10 +122,20 @@ func main() {
 	config := cfg.LoadWtfConfigFile(flags.ConfigFilePath())
 	flags.RenderIf(version, config)
 
+	if flags.HasAddWidget() {
+		addWidgetFromStdin(config, flags.ConfigFilePath())
+	}
+
+	if flags.HasValidate() {
+		validateConfig(config, flags.ConfigFilePath())
+	}
+
 	if flags.Profile {
 		defer profile.Start(profile.MemProfile).Stop()
 	}
 
+	initLogger(config, flags)
+
 	openUrlUtil := utils.ToStrs(config.UList("wtf.openUrlUtil", []interface{}{}))
 	utils.Init(config.UString("wtf.openFileUtil", "open"), openUrlUtil)
 
@@ -67,7 +143,7 @@ func main() {
 
 	// Build the application
 	tviewApp = tview.NewApplication()
-	wtfApp := app.NewWtfApp(tviewApp, config, flags.Config)
+	wtfApp := app.NewWtfApp(tviewApp, config, flags.Config, flags.Only)
 	wtfApp.Start()
 
 	if err := tviewApp.Run(); err != nil {
@@ -5,8 +5,10 @@ import (
 )
 
 type Clock struct {
-	Label    string
-	Location *time.Location
+	Label      string
+	Location   *time.Location
+	DateFormat string
+	TimeFormat string
 }
 
 func NewClock(label string, timeLoc *time.Location) Clock {
@@ -18,8 +20,10 @@ func NewClock(label string, timeLoc *time.Location) Clock {
 	return clock
 }
 
-func (clock *Clock) Date(dateFormat string) string {
-	return clock.LocalTime().Format(dateFormat)
+// Date returns the clock's local date, formatted using its own DateFormat if one was
+// configured, falling back to the widget-wide defaultFormat otherwise
+func (clock *Clock) Date(defaultFormat string) string {
+	return clock.LocalTime().Format(clock.dateFormat(defaultFormat))
 }
 
 func (clock *Clock) LocalTime() time.Time {
@@ -30,6 +34,24 @@ func (clock *Clock) ToLocal(t time.Time) time.Time {
 	return t.In(clock.Location)
 }
 
-func (clock *Clock) Time(timeFormat string) string {
-	return clock.LocalTime().Format(timeFormat)
+// Time returns the clock's local time, formatted using its own TimeFormat if one was
+// configured, falling back to the widget-wide defaultFormat otherwise
+func (clock *Clock) Time(defaultFormat string) string {
+	return clock.LocalTime().Format(clock.timeFormat(defaultFormat))
+}
+
+func (clock *Clock) dateFormat(defaultFormat string) string {
+	if clock.DateFormat != "" {
+		return clock.DateFormat
+	}
+
+	return defaultFormat
+}
+
+func (clock *Clock) timeFormat(defaultFormat string) string {
+	if clock.TimeFormat != "" {
+		return clock.TimeFormat
+	}
+
+	return defaultFormat
 }
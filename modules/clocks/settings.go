@@ -17,7 +17,7 @@ type Settings struct {
 
 	dateFormat string                 `help:"The format of the date string for all clocks." values:"Any valid Go date layout which is handled by Time.Format. Defaults to Jan 2."`
 	timeFormat string                 `help:"The format of the time string for all clocks." values:"Any valid Go time layout which is handled by Time.Format. Defaults to 15:04 MST."`
-	locations  map[string]interface{} `help:"Defines the timezones for the world clocks that you want to display. key is a unique label that will be displayed in the UI. value is a timezone name." values:"Any TZ database timezone."`
+	locations  map[string]interface{} `help:"Defines the timezones for the world clocks that you want to display. key is a unique label that will be displayed in the UI. value is either a timezone name, or a block of timezone/dateFormat/timeFormat/24h to override this clock's format." values:"Any TZ database timezone, optionally paired with per-clock dateFormat/timeFormat/24h overrides."`
 	sort       string                 `help:"Defines the display order of the clocks in the widget." values:"'alphabetical' or 'chronological'. 'alphabetical' will sort in acending order by key, 'chronological' will sort in ascending order by date/time."`
 }
 
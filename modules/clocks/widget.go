@@ -48,18 +48,64 @@ func (widget *Widget) Refresh() {
 func (widget *Widget) buildClockCollection(locData map[string]interface{}) ClockCollection {
 	clockColl := ClockCollection{}
 
-	for label, locStr := range locData {
-		timeLoc, err := time.LoadLocation(widget.sanitizeLocation(locStr.(string)))
+	for label, locVal := range locData {
+		timezone, dateFormat, timeFormat, is24hr := widget.parseLocation(locVal)
+
+		timeLoc, err := time.LoadLocation(widget.sanitizeLocation(timezone))
 		if err != nil {
 			continue
 		}
 
-		clockColl.Clocks = append(clockColl.Clocks, NewClock(label, timeLoc))
+		clock := NewClock(label, timeLoc)
+		clock.DateFormat = dateFormat
+		clock.TimeFormat = timeFormat
+
+		if clock.TimeFormat == "" && is24hr != nil {
+			if *is24hr {
+				clock.TimeFormat = "15:04"
+			} else {
+				clock.TimeFormat = "3:04 PM"
+			}
+		}
+
+		clockColl.Clocks = append(clockColl.Clocks, clock)
 	}
 
 	return clockColl
 }
 
+// parseLocation reads a single entry from the "locations" config map, supporting both the
+// plain `label: timezone` shorthand and a per-clock block of
+// `timezone`/`dateFormat`/`timeFormat`/`24h` overrides
+func (widget *Widget) parseLocation(locVal interface{}) (timezone, dateFormat, timeFormat string, is24hr *bool) {
+	if tz, ok := locVal.(string); ok {
+		return tz, "", "", nil
+	}
+
+	locMap, ok := locVal.(map[string]interface{})
+	if !ok {
+		return "", "", "", nil
+	}
+
+	if tz, ok := locMap["timezone"].(string); ok {
+		timezone = tz
+	}
+
+	if df, ok := locMap["dateFormat"].(string); ok {
+		dateFormat = df
+	}
+
+	if tf, ok := locMap["timeFormat"].(string); ok {
+		timeFormat = tf
+	}
+
+	if hr24, ok := locMap["24h"].(bool); ok {
+		is24hr = &hr24
+	}
+
+	return timezone, dateFormat, timeFormat, is24hr
+}
+
 func (widget *Widget) sanitizeLocation(locStr string) string {
 	return strings.Replace(locStr, " ", "_", -1)
 }
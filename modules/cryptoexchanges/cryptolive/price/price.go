@@ -11,11 +11,19 @@ type fromCurrency struct {
 }
 
 type toCurrency struct {
-	name  string
-	price float32
+	name            string
+	price           float32
+	changePct24Hour float32
 }
 
-type cResponse map[string]float32
+// rawPrice is a single "from/to" entry in a pricemultifull response's RAW section
+type rawPrice struct {
+	Price           float32 `json:"PRICE"`
+	ChangePct24Hour float32 `json:"CHANGEPCT24HOUR"`
+}
+
+// cResponse is the RAW section of a pricemultifull response: from-currency to to-currency to price data
+type cResponse map[string]map[string]rawPrice
 
 /* -------------------- Unexported Functions -------------------- */
 
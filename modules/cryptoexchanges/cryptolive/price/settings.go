@@ -18,6 +18,8 @@ type colors struct {
 	to struct {
 		name  string
 		price string
+		up    string
+		down  string
 	}
 	top struct {
 		from struct {
@@ -39,8 +41,9 @@ type currency struct {
 
 type Settings struct {
 	colors
-	common     *cfg.Common
-	currencies map[string]*currency
+	common       *cfg.Common
+	currencies   map[string]*currency
+	minCacheSecs int
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -54,6 +57,10 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 
 	settings.colors.to.name = ymlConfig.UString("colors.to.name")
 	settings.colors.to.price = ymlConfig.UString("colors.to.price")
+	settings.colors.to.up = ymlConfig.UString("colors.to.up", "green")
+	settings.colors.to.down = ymlConfig.UString("colors.to.down", "red")
+
+	settings.minCacheSecs = ymlConfig.UInt("minCacheSecs", 30)
 
 	settings.colors.top.from.name = ymlConfig.UString("colors.top.from.name")
 	settings.colors.top.from.displayName = ymlConfig.UString("colors.top.from.displayName")
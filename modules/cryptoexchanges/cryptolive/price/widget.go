@@ -8,7 +8,7 @@ import (
 	"time"
 )
 
-var baseURL = "https://min-api.cryptocompare.com/data/price"
+var baseURL = "https://min-api.cryptocompare.com/data/pricemultifull"
 var ok = true
 
 // Widget define wtf widget to register widget later
@@ -19,6 +19,8 @@ type Widget struct {
 	Result string
 
 	RefreshInterval int
+
+	lastFetch time.Time
 }
 
 // NewWidget Make new instance of widget
@@ -43,17 +45,34 @@ func (widget *Widget) setList() {
 
 /* -------------------- Exported Functions -------------------- */
 
-// Refresh & update after interval time
+// Refresh & update after interval time. If the previous fetch happened more recently than
+// minCacheSecs ago, the cached prices are redisplayed instead of hitting the provider
+// again, so a short refreshInterval doesn't run into its rate limits
 func (widget *Widget) Refresh(wg *sync.WaitGroup) {
-	if len(widget.list.items) != 0 {
-		widget.updateCurrencies()
-		if !ok {
-			widget.Result = fmt.Sprint("Please check your internet connection!")
-		} else {
-			widget.display()
+	defer wg.Done()
+
+	if len(widget.list.items) == 0 {
+		return
+	}
+
+	cacheTTL := time.Duration(widget.settings.minCacheSecs) * time.Second
+	if !widget.lastFetch.IsZero() && time.Since(widget.lastFetch) < cacheTTL {
+		widget.display()
+		return
+	}
+
+	widget.updateCurrencies()
+	if !ok {
+		// Keep showing the last successfully-fetched prices rather than blanking the
+		// widget over a transient rate limit or network blip
+		if widget.Result == "" {
+			widget.Result = "Please check your internet connection!"
 		}
+		return
 	}
-	wg.Done()
+
+	widget.lastFetch = time.Now()
+	widget.display()
 }
 
 /* -------------------- Unexported Functions -------------------- */
@@ -70,12 +89,22 @@ func (widget *Widget) display() {
 			item.name,
 		)
 		for _, toItem := range item.to {
+			changeColor := widget.settings.colors.to.up
+			arrow := "▲"
+			if toItem.changePct24Hour < 0 {
+				changeColor = widget.settings.colors.to.down
+				arrow = "▼"
+			}
+
 			str += fmt.Sprintf(
-				"\t[%s]%s: [%s]%f\n",
+				"\t[%s]%s: [%s]%f [%s]%s%.2f%%\n",
 				widget.settings.colors.to.name,
 				toItem.name,
 				widget.settings.colors.to.price,
 				toItem.price,
+				changeColor,
+				arrow,
+				toItem.changePct24Hour,
 			)
 		}
 		str += "\n"
@@ -105,7 +134,9 @@ func (widget *Widget) updateCurrencies() {
 
 		var (
 			client       http.Client
-			jsonResponse cResponse
+			jsonResponse struct {
+				Raw cResponse `json:"RAW"`
+			}
 		)
 
 		client = http.Client{
@@ -125,7 +156,7 @@ func (widget *Widget) updateCurrencies() {
 
 		_ = json.NewDecoder(response.Body).Decode(&jsonResponse)
 
-		setPrices(&jsonResponse, fromCurrency)
+		setPrices(&jsonResponse.Raw, fromCurrency)
 	}
 
 }
@@ -137,7 +168,7 @@ func makeRequest(currency *fromCurrency) *http.Request {
 		tsyms += fmt.Sprintf("%s,", to.name)
 	}
 
-	url := fmt.Sprintf("%s?fsym=%s&tsyms=%s", baseURL, fsym, tsyms)
+	url := fmt.Sprintf("%s?fsyms=%s&tsyms=%s", baseURL, fsym, tsyms)
 	request, err := http.NewRequest("GET", url, nil)
 
 	if err != nil {
@@ -147,7 +178,18 @@ func makeRequest(currency *fromCurrency) *http.Request {
 }
 
 func setPrices(response *cResponse, currencry *fromCurrency) {
+	toPrices, ok := (*response)[currencry.name]
+	if !ok {
+		return
+	}
+
 	for idx, toCurrency := range currencry.to {
-		currencry.to[idx].price = (*response)[toCurrency.name]
+		raw, ok := toPrices[toCurrency.name]
+		if !ok {
+			continue
+		}
+
+		currencry.to[idx].price = raw.Price
+		currencry.to[idx].changePct24Hour = raw.ChangePct24Hour
 	}
 }
@@ -0,0 +1,91 @@
+package digitalocean
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/wtfutil/wtf/view"
+)
+
+// actionHistoryLimit is the number of most recent actions fetched for a droplet
+const actionHistoryLimit = 25
+
+// dropletActionHistory fetches the selected droplet's most recent actions, newest first
+func (widget *Widget) dropletActionHistory(client doClient, droplet *godo.Droplet) ([]godo.Action, error) {
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	actions, _, err := client.Droplets().Actions(ctx, droplet.ID, &godo.ListOptions{PerPage: actionHistoryLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// renderActionHistory formats a droplet's actions as a table of type, status, and
+// started/completed times, most recent first
+func renderActionHistory(actions []godo.Action) string {
+	if len(actions) == 0 {
+		return "No actions found for this droplet."
+	}
+
+	var out strings.Builder
+
+	for _, action := range actions {
+		out.WriteString(fmt.Sprintf(
+			"[green]%-16s[white] %-12s started: %-20s completed: %-20s\n",
+			action.Type,
+			action.Status,
+			actionTimestamp(action.StartedAt),
+			actionTimestamp(action.CompletedAt),
+		))
+	}
+
+	return out.String()
+}
+
+// actionTimestamp returns a formatted timestamp, or a placeholder if the action hasn't
+// reached that stage yet (DigitalOcean omits StartedAt/CompletedAt until they occur)
+func actionTimestamp(timestamp *godo.Timestamp) string {
+	if timestamp == nil {
+		return "-"
+	}
+
+	return timestamp.Time.Format("2006-01-02 15:04:05")
+}
+
+// showActionHistory shows a modal window listing the selected droplet's recent actions
+func (widget *Widget) showActionHistory() {
+	droplet := widget.currentDroplet()
+	if droplet == nil {
+		return
+	}
+
+	client := widget.clientFor(widget.currentAccount())
+	if client == nil {
+		return
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage("actionHistory")
+		widget.app.SetFocus(widget.View)
+	}
+
+	actions, err := widget.dropletActionHistory(client, droplet)
+	if err != nil {
+		widget.showTransientMessage(fmt.Sprintf("Could not fetch action history: %v", err))
+		return
+	}
+
+	modal := view.NewBillboardModal(renderActionHistory(actions), closeFunc)
+	modal.SetTitle(fmt.Sprintf("  %s actions  ", droplet.Name))
+
+	widget.pages.AddPage("actionHistory", modal, false, true)
+	widget.app.SetFocus(modal)
+
+	widget.app.QueueUpdateDraw(func() {
+		widget.app.Draw()
+	})
+}
@@ -0,0 +1,32 @@
+package digitalocean
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_renderActionHistory_empty(t *testing.T) {
+	assert.Equal(t, "No actions found for this droplet.", renderActionHistory(nil))
+}
+
+func Test_renderActionHistory(t *testing.T) {
+	started := godo.Timestamp{}
+	actions := []godo.Action{
+		{Type: "reboot", Status: "completed", StartedAt: &started, CompletedAt: &started},
+		{Type: "power_on", Status: "in-progress"},
+	}
+
+	rendered := renderActionHistory(actions)
+
+	assert.Contains(t, rendered, "reboot")
+	assert.Contains(t, rendered, "completed")
+	assert.Contains(t, rendered, "power_on")
+	assert.Contains(t, rendered, "in-progress")
+	assert.Contains(t, rendered, "-")
+}
+
+func Test_actionTimestamp_nil(t *testing.T) {
+	assert.Equal(t, "-", actionTimestamp(nil))
+}
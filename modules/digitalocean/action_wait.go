@@ -0,0 +1,54 @@
+package digitalocean
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// actionPollInterval is how often an in-progress action's status is checked when
+// waitForActions is enabled
+const actionPollInterval = 2 * time.Second
+
+// actionPollTimeout is how long to keep polling an action before giving up and
+// refreshing anyway
+const actionPollTimeout = 2 * time.Minute
+
+// watchAction refreshes the widget right away. With waitForActions enabled, it instead
+// waits for the given action to complete: it polls the action's status every actionPollInterval, up to
+// actionPollTimeout, showing an "<type> (in-progress)" indicator on the droplet's row in
+// the meantime, since reboots/shutdowns/resizes are asynchronous and an immediate refresh
+// would just show stale state.
+func (widget *Widget) watchAction(client doClient, dropletID int, action *godo.Action) {
+	if !widget.settings.waitForActions || action == nil {
+		widget.Refresh()
+		return
+	}
+
+	widget.pendingActions[dropletID] = fmt.Sprintf("%s (in-progress)", action.Type)
+	widget.display()
+
+	widget.pollAction(client, dropletID, action.ID, time.Now().Add(actionPollTimeout))
+}
+
+// pollAction checks on an action in progress, rescheduling itself every actionPollInterval
+// until the action is no longer in-progress or the deadline passes, then clears the
+// droplet's indicator and refreshes
+func (widget *Widget) pollAction(client doClient, dropletID, actionID int, deadline time.Time) {
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	current, _, err := client.Actions().Get(ctx, actionID)
+	if err != nil || current.Status != "in-progress" || time.Now().After(deadline) {
+		delete(widget.pendingActions, dropletID)
+		widget.Refresh()
+		return
+	}
+
+	time.AfterFunc(actionPollInterval, func() {
+		widget.app.QueueUpdateDraw(func() {
+			widget.pollAction(client, dropletID, actionID, deadline)
+		})
+	})
+}
@@ -0,0 +1,87 @@
+package balance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+	"golang.org/x/oauth2"
+)
+
+type tokenSource struct {
+	AccessToken string
+}
+
+func (t *tokenSource) Token() (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: t.AccessToken}, nil
+}
+
+// Widget is the container for DigitalOcean account balance data
+type Widget struct {
+	view.TextWidget
+
+	client   *godo.Client
+	settings *Settings
+	err      error
+}
+
+// NewWidget creates a new instance of a widget
+func NewWidget(app *tview.Application, settings *Settings) *Widget {
+	widget := &Widget{
+		TextWidget: view.NewTextWidget(app, settings.common),
+
+		settings: settings,
+	}
+
+	if widget.settings.apiKey != "" {
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, utils.NewProxiedHTTPClient(""))
+		oauthClient := oauth2.NewClient(ctx, &tokenSource{AccessToken: widget.settings.apiKey})
+		widget.client = godo.NewClient(oauthClient)
+	}
+
+	return widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Refresh updates the data for this widget and displays it onscreen
+func (widget *Widget) Refresh() {
+	widget.err = widget.fetch()
+	widget.Redraw(widget.content)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// fetch populates account balance and month-to-date usage. The vendored DigitalOcean
+// client in this module predates godo's Balance and BillingHistory services, so there's
+// nothing to actually call yet; this returns a clear error explaining that instead of
+// pretending to have live numbers.
+func (widget *Widget) fetch() error {
+	if widget.client == nil {
+		return errors.New("no DigitalOcean apiKey configured")
+	}
+
+	return errors.New("account balance requires a newer version of the godo client than this module vendors (no Balance/BillingHistory service)")
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		return title, widget.err.Error(), true
+	}
+
+	str := fmt.Sprintf(
+		" %10s: %s\n %10s: %s\n %10s: %s\n",
+		"MTD Usage", "N/A",
+		"Balance", "N/A",
+		"As of", time.Now().Format("Jan 2, 2006 15:04:05"),
+	)
+
+	return title, str, false
+}
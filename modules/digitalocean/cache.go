@@ -0,0 +1,92 @@
+package digitalocean
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+// dropletCache is the on-disk representation of a widget's last successful fetch
+type dropletCache struct {
+	Droplets  []godo.Droplet `json:"droplets"`
+	Accounts  []string       `json:"accounts"`
+	FetchedAt time.Time      `json:"fetchedAt"`
+}
+
+// cacheFilePath returns the path to this widget's on-disk droplet cache file
+func (widget *Widget) cacheFilePath() (string, error) {
+	configDir, err := cfg.WtfConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "cache", "digitalocean-"+widget.Name()+".json"), nil
+}
+
+// loadCachedDroplets populates the widget with whatever droplet data was cached on a
+// previous run, so the widget shows stale-but-useful data immediately on startup instead
+// of an empty list while the first live fetch is in flight. Does nothing if caching is
+// disabled or there's no usable cache file yet.
+func (widget *Widget) loadCachedDroplets() {
+	if !widget.settings.cache {
+		return
+	}
+
+	path, err := widget.cacheFilePath()
+	if err != nil {
+		return
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	var cached dropletCache
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return
+	}
+
+	widget.droplets = cached.Droplets
+	widget.accounts = cached.Accounts
+	widget.dataAsOf = cached.FetchedAt
+	widget.dataStale = true
+
+	widget.sortDroplets()
+	widget.SetItemCount(len(widget.droplets))
+}
+
+// cacheDroplets writes the widget's current droplet data to disk, stamped with
+// fetchedAt, so it can be loaded back by loadCachedDroplets on the next startup
+func (widget *Widget) cacheDroplets(fetchedAt time.Time) {
+	if !widget.settings.cache {
+		return
+	}
+
+	path, err := widget.cacheFilePath()
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+
+	cached := dropletCache{
+		Droplets:  widget.droplets,
+		Accounts:  widget.accounts,
+		FetchedAt: fetchedAt,
+	}
+
+	data, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	_ = ioutil.WriteFile(path, data, 0600)
+}
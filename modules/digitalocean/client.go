@@ -0,0 +1,35 @@
+package digitalocean
+
+import "github.com/digitalocean/godo"
+
+// doClient is the subset of a godo.Client's API this widget uses, abstracted behind an
+// interface so tests can inject a fake instead of making real DigitalOcean API calls
+type doClient interface {
+	Actions() godo.ActionsService
+	Droplets() godo.DropletsService
+	DropletActions() godo.DropletActionsService
+	Sizes() godo.SizesService
+	FloatingIPs() godo.FloatingIPsService
+	FloatingIPActions() godo.FloatingIPActionsService
+	Monitoring() godo.MonitoringService
+	Storage() godo.StorageService
+	Tags() godo.TagsService
+}
+
+// realClient adapts a *godo.Client, whose services are exported fields, to the
+// method-based doClient interface
+type realClient struct {
+	client *godo.Client
+}
+
+func (c realClient) Actions() godo.ActionsService               { return c.client.Actions }
+func (c realClient) Droplets() godo.DropletsService             { return c.client.Droplets }
+func (c realClient) DropletActions() godo.DropletActionsService { return c.client.DropletActions }
+func (c realClient) Sizes() godo.SizesService                   { return c.client.Sizes }
+func (c realClient) FloatingIPs() godo.FloatingIPsService       { return c.client.FloatingIPs }
+func (c realClient) FloatingIPActions() godo.FloatingIPActionsService {
+	return c.client.FloatingIPActions
+}
+func (c realClient) Monitoring() godo.MonitoringService { return c.client.Monitoring }
+func (c realClient) Storage() godo.StorageService       { return c.client.Storage }
+func (c realClient) Tags() godo.TagsService             { return c.client.Tags }
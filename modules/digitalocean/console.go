@@ -0,0 +1,48 @@
+package digitalocean
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+// consoleURL returns the DigitalOcean web console URL for the given droplet
+func consoleURL(droplet int) string {
+	return fmt.Sprintf("https://cloud.digitalocean.com/droplets/%d", droplet)
+}
+
+// hasDisplay returns true if a graphical session appears to be available to open a
+// browser in. Always true outside Linux, where there's no equivalent cheap check
+func hasDisplay() bool {
+	if runtime.GOOS != "linux" {
+		return true
+	}
+
+	return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+}
+
+// openDropletConsole opens the selected droplet's page in the DigitalOcean web console using
+// the browser-open helper other widgets use. On a headless system with no browser available,
+// it copies the URL to the clipboard and notifies instead
+func (widget *Widget) openDropletConsole() {
+	currDroplet := widget.currentDroplet()
+	if currDroplet == nil {
+		return
+	}
+
+	url := consoleURL(currDroplet.ID)
+
+	if !hasDisplay() {
+		if err := utils.CopyToClipboard(url); err != nil {
+			widget.showTransientMessage(fmt.Sprintf("Could not copy console URL: %v", err))
+			return
+		}
+
+		widget.showTransientMessage("No browser available, copied console URL to clipboard")
+		return
+	}
+
+	utils.OpenFile(url)
+}
@@ -2,39 +2,260 @@ package digitalocean
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/digitalocean/godo"
+	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/utils"
 )
 
 func (widget *Widget) content() (string, string, bool) {
 	title := widget.CommonSettings().Title
 	if widget.err != nil {
+		title = fmt.Sprintf("%s [red]![white]", title)
 		return title, widget.err.Error(), true
 	}
 
+	title = fmt.Sprintf("%s (%d)", title, len(widget.droplets))
+
+	if widget.truncated {
+		title = fmt.Sprintf("%s (truncated at %d)", title, widget.settings.maxItems)
+	}
+
+	if widget.dataStale {
+		title = fmt.Sprintf("%s (cached as of %s)", title, widget.dataAsOf.Format("15:04:05"))
+	}
+
+	if widget.FilterText() != "" {
+		title = fmt.Sprintf("%s (filter: %s)", title, widget.FilterText())
+	}
+
+	if widget.Refreshing() {
+		title = fmt.Sprintf("%s (refreshing…)", title)
+
+		if len(widget.droplets) == 0 {
+			return title, " Loading…\n", false
+		}
+	}
+
+	if widget.showFloatingIPs {
+		return widget.floatingIPsContent(title)
+	}
+
 	str := fmt.Sprintf(
 		" [%s]Droplets\n\n",
 		widget.settings.common.Colors.Subheading,
 	)
 
+	lastGroup := ""
+
 	for idx, droplet := range widget.droplets {
+		if !widget.Matches(idx) {
+			continue
+		}
+
+		if widget.settings.groupBy != "" {
+			group := widget.dropletGroup(droplet)
+			if group != lastGroup {
+				str += fmt.Sprintf(" [%s::b]%s\n", widget.settings.common.Colors.Subheading, group)
+				lastGroup = group
+			}
+		}
+
 		dropletName := droplet.Name
+		if widget.selectedIDs[droplet.ID] {
+			dropletName = fmt.Sprintf("[x] %s", dropletName)
+		}
+
+		account := ""
+		if len(widget.settings.apiKeys) > 1 && idx < len(widget.accounts) {
+			account = fmt.Sprintf(" %-8s", utils.Truncate(widget.accounts[idx], 8, false))
+		}
+
+		selected := widget.View.HasFocus() && idx == widget.Selected
+
+		rowColor := widget.RowColor(idx)
+		if droplet.Region != nil && !selected {
+			if regionColor, ok := widget.settings.regionColors[droplet.Region.Slug]; ok {
+				rowColor = regionColor
+			}
+		}
+
+		status := ""
+		if widget.settings.showStatus {
+			status = fmt.Sprintf(" [%s]%-8s[%s]", dropletStatusColor(droplet.Status), droplet.Status, rowColor)
+		}
+
+		ip := ""
+		region := ""
+		if widget.settings.showIP {
+			publicIP, _ := droplet.PublicIPv4()
+			ip = fmt.Sprintf(" %-15s", publicIP)
+
+			if droplet.Region != nil {
+				region = fmt.Sprintf(" %-6s", droplet.Region.Slug)
+			}
+		}
+
+		created := ""
+		if widget.settings.showCreated {
+			created = fmt.Sprintf(" %-20s", widget.createdDisplay(droplet))
+		}
+
+		pending := ""
+		if action, ok := widget.pendingActions[droplet.ID]; ok {
+			pending = fmt.Sprintf(" [yellow]%s[%s]", action, rowColor)
+		}
 
 		row := fmt.Sprintf(
-			"[%s] %-8s %-24s %s",
-			widget.RowColor(idx),
-			droplet.Status,
+			"[%s]%s%s%s%s%s %-24s %s%s",
+			rowColor,
+			account,
+			status,
+			ip,
+			region,
+			created,
 			dropletName,
 			utils.Truncate(strings.Join(droplet.Tags, ","), 24, true),
+			pending,
 		)
 
 		str += utils.HighlightableHelper(widget.View, row, idx, 33)
 	}
 
+	if widget.settings.showRateLimit {
+		str += widget.rateLimitFooter()
+	}
+
+	if widget.settings.detailPane {
+		str += widget.detailPaneFooter()
+	}
+
 	return title, str, false
 }
 
+// detailPaneFooter renders the currently-selected droplet's properties table below the
+// droplet list, re-rendered on every redraw so it tracks the selection as it moves
+func (widget *Widget) detailPaneFooter() string {
+	droplet := widget.currentDroplet()
+	if droplet == nil {
+		return ""
+	}
+
+	// Skip the live dropletSnapshotCount and dropletMetrics calls here - they're uncached
+	// API requests and this pane re-renders on every cursor move, unlike the info modal
+	// which only renders once per open
+	propTable := newDropletPropertiesTable(droplet, -1, widget.dropletVolumes(droplet), widget.settings.showMetrics, "", "", widget.settings.relativeTime)
+
+	return fmt.Sprintf(
+		"\n [%s]%s\n%s",
+		widget.settings.common.Colors.Subheading,
+		droplet.Name,
+		propTable.render(),
+	)
+}
+
+// rateLimitFooter renders each account's remaining API quota and reset time, one line per
+// account, sorted by label so the footer doesn't jump around between refreshes
+func (widget *Widget) rateLimitFooter() string {
+	labels := make([]string, 0, len(widget.rateLimits))
+	for label := range widget.rateLimits {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	rows := make([][]string, 0, len(labels))
+	for _, label := range labels {
+		rate := widget.rateLimits[label]
+		rows = append(rows, []string{
+			utils.Truncate(label, 8, false),
+			fmt.Sprintf("%d/%d remaining", rate.Remaining, rate.Limit),
+			fmt.Sprintf("resets %s", rate.Reset.Time.Format("15:04:05")),
+		})
+	}
+
+	str := fmt.Sprintf(
+		"\n [%s]API quota\n",
+		widget.settings.common.Colors.Subheading,
+	)
+
+	for _, line := range utils.Columns(rows, []int{tview.AlignLeft, tview.AlignRight, tview.AlignLeft}) {
+		str += " " + line + "\n"
+	}
+
+	return str
+}
+
+// floatingIPsContent renders the alternate reserved/floating IPs view
+func (widget *Widget) floatingIPsContent(title string) (string, string, bool) {
+	str := fmt.Sprintf(
+		" [%s]Reserved IPs\n\n",
+		widget.settings.common.Colors.Subheading,
+	)
+
+	for idx, ip := range widget.floatingIPs {
+		dropletName := "unassigned"
+		if ip.Droplet != nil {
+			dropletName = ip.Droplet.Name
+		}
+
+		region := ""
+		if ip.Region != nil {
+			region = ip.Region.Slug
+		}
+
+		row := fmt.Sprintf(
+			"[%s]%-16s %-8s %-24s %s",
+			widget.RowColor(idx),
+			ip.IP,
+			region,
+			dropletName,
+			ip.account,
+		)
+
+		str += utils.HighlightableHelper(widget.View, row, idx, 33)
+	}
+
+	return title + " — Reserved IPs", str, false
+}
+
+// createdDisplay returns droplet's creation timestamp, rendered as a relative age like
+// "3 days ago" when relativeTime is enabled, or the absolute timestamp otherwise
+func (widget *Widget) createdDisplay(droplet godo.Droplet) string {
+	if widget.settings.relativeTime {
+		return utils.RelativeTime(droplet.Created)
+	}
+
+	return droplet.Created
+}
+
+// dropletStatusColor returns the display color for a droplet's status
+func dropletStatusColor(status string) string {
+	switch status {
+	case "active":
+		return "green"
+	case "new":
+		return "yellow"
+	case "off":
+		return "red"
+	default:
+		return "white"
+	}
+}
+
+// display redraws the widget, but skips the actual Clear/SetText/SetTitle cycle when the
+// title, body, and wrap mode are identical to what was last rendered, to avoid flicker on
+// terminals that refresh frequently but whose droplet list rarely changes
 func (widget *Widget) display() {
+	title, body, wrap := widget.content()
+
+	if widget.hasRendered && title == widget.lastRenderedTitle && body == widget.lastRenderedBody && wrap == widget.lastRenderedWrap {
+		return
+	}
+
+	widget.lastRenderedTitle, widget.lastRenderedBody, widget.lastRenderedWrap = title, body, wrap
+	widget.hasRendered = true
+
 	widget.ScrollableWidget.Redraw(widget.content)
 }
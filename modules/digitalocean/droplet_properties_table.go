@@ -11,22 +11,43 @@ import (
 )
 
 type dropletPropertiesTable struct {
-	droplet     *godo.Droplet
-	propertyMap map[string]string
+	droplet       *godo.Droplet
+	snapshotCount int
+	volumes       []godo.Volume
+	showMetrics   bool
+	cpuUsage      string
+	memoryUsage   string
+	relativeTime  bool
+	propertyMap   map[string]string
 
 	colWidth0   int
 	colWidth1   int
+	maxValueLen int
 	tableHeight int
 }
 
 // newDropletPropertiesTable creates and returns an instance of DropletPropertiesTable
-func newDropletPropertiesTable(droplet *godo.Droplet) *dropletPropertiesTable {
+// snapshotCount is the number of existing snapshots for the droplet, or -1 if unknown.
+// cpuUsage and memoryUsage are the droplet's latest utilization percentages, already
+// fetched by the caller, or empty if they couldn't be determined
+func newDropletPropertiesTable(droplet *godo.Droplet, snapshotCount int, volumes []godo.Volume, showMetrics bool, cpuUsage, memoryUsage string, relativeTime bool) *dropletPropertiesTable {
 	propTable := &dropletPropertiesTable{
-		droplet: droplet,
+		droplet:       droplet,
+		snapshotCount: snapshotCount,
+		volumes:       volumes,
+		showMetrics:   showMetrics,
+		cpuUsage:      cpuUsage,
+		memoryUsage:   memoryUsage,
+		relativeTime:  relativeTime,
 
 		colWidth0:   24,
 		colWidth1:   47,
-		tableHeight: 16,
+		tableHeight: 17,
+	}
+	propTable.maxValueLen = propTable.colWidth1 * 4
+
+	if showMetrics {
+		propTable.tableHeight += 2
 	}
 
 	propTable.propertyMap = propTable.buildPropertyMap()
@@ -48,23 +69,74 @@ func (propTable *dropletPropertiesTable) buildPropertyMap() map[string]string {
 	publicV6, _ := propTable.droplet.PublicIPv6()
 
 	propMap["CPUs"] = strconv.Itoa(propTable.droplet.Vcpus)
-	propMap["Created"] = propTable.droplet.Created
+	propMap["Created"] = propTable.createdDisplay()
+
+	if propTable.showMetrics {
+		propMap["CPU Usage"] = propTable.metricDisplay(propTable.cpuUsage)
+		propMap["Memory Usage"] = propTable.metricDisplay(propTable.memoryUsage)
+	}
 	propMap["Disk"] = strconv.Itoa(propTable.droplet.Disk)
-	propMap["Features"] = utils.Truncate(strings.Join(propTable.droplet.Features, ","), propTable.colWidth1, true)
+	propMap["Features"] = utils.Truncate(strings.Join(propTable.droplet.Features, ","), propTable.maxValueLen, true)
 	propMap["Image"] = fmt.Sprintf("%s (%s)", propTable.droplet.Image.Name, propTable.droplet.Image.Distribution)
 	propMap["Memory"] = strconv.Itoa(propTable.droplet.Memory)
 	propMap["Public IP v4"] = publicV4
 	propMap["Public IP v6"] = publicV6
 	propMap["Region"] = fmt.Sprintf("%s (%s)", propTable.droplet.Region.Name, propTable.droplet.Region.Slug)
 	propMap["Size"] = propTable.droplet.SizeSlug
+	propMap["Snapshots"] = propTable.snapshotCountDisplay()
 	propMap["Status"] = propTable.droplet.Status
-	propMap["Tags"] = utils.Truncate(strings.Join(propTable.droplet.Tags, ","), propTable.colWidth1, true)
-	propMap["URN"] = utils.Truncate(propTable.droplet.URN(), propTable.colWidth1, true)
+	propMap["Tags"] = utils.Truncate(strings.Join(propTable.droplet.Tags, ","), propTable.maxValueLen, true)
+	propMap["URN"] = utils.Truncate(propTable.droplet.URN(), propTable.maxValueLen, true)
+	propMap["Volumes"] = propTable.volumesDisplay()
 	propMap["VPC"] = propTable.droplet.VPCUUID
 
 	return propMap
 }
 
+// volumesDisplay renders each attached volume's name, size, and mount status as a
+// comma-separated list, or "none" if the droplet has no attached volumes
+func (propTable *dropletPropertiesTable) volumesDisplay() string {
+	if len(propTable.volumes) == 0 {
+		return "none"
+	}
+
+	names := make([]string, len(propTable.volumes))
+	for idx, volume := range propTable.volumes {
+		names[idx] = fmt.Sprintf("%s (%dGB, attached)", volume.Name, volume.SizeGigaBytes)
+	}
+
+	return utils.Truncate(strings.Join(names, ", "), propTable.maxValueLen, true)
+}
+
+// createdDisplay returns the droplet's creation timestamp, rendered as a relative age like
+// "3 days ago" when relativeTime is enabled, or the absolute timestamp otherwise
+func (propTable *dropletPropertiesTable) createdDisplay() string {
+	if propTable.relativeTime {
+		return utils.RelativeTime(propTable.droplet.Created)
+	}
+
+	return propTable.droplet.Created
+}
+
+// snapshotCountDisplay returns the snapshot count as a string, or "unknown" if it couldn't be determined
+func (propTable *dropletPropertiesTable) snapshotCountDisplay() string {
+	if propTable.snapshotCount < 0 {
+		return "unknown"
+	}
+
+	return strconv.Itoa(propTable.snapshotCount)
+}
+
+// metricDisplay returns value, or "unknown" if it's empty - fetching a metric can come up
+// empty if monitoring isn't enabled on the droplet, or there isn't yet enough data
+func (propTable *dropletPropertiesTable) metricDisplay(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+
+	return value
+}
+
 // render creates a new Table and returns it as a displayable string
 func (propTable *dropletPropertiesTable) render() string {
 	tbl := view.NewInfoTable(
@@ -0,0 +1,50 @@
+package digitalocean
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+)
+
+func Test_createdDisplay(t *testing.T) {
+	droplet := &godo.Droplet{
+		Created: "2020-01-01T00:00:00Z",
+		Image:   &godo.Image{},
+		Region:  &godo.Region{},
+	}
+
+	propTable := newDropletPropertiesTable(droplet, -1, nil, false, "", "", false)
+	if got := propTable.createdDisplay(); got != droplet.Created {
+		t.Errorf("expected the absolute timestamp %q, got %q", droplet.Created, got)
+	}
+
+	propTable.relativeTime = true
+	if got := propTable.createdDisplay(); got == droplet.Created {
+		t.Errorf("expected a relative time, got the unchanged absolute timestamp %q", got)
+	}
+}
+
+func Test_buildPropertyMap_truncatesLongTags(t *testing.T) {
+	tags := make([]string, 50)
+	for i := range tags {
+		tags[i] = "a-fairly-long-tag-name"
+	}
+
+	droplet := &godo.Droplet{
+		Tags:   tags,
+		Image:  &godo.Image{},
+		Region: &godo.Region{},
+	}
+
+	propTable := newDropletPropertiesTable(droplet, -1, nil, false, "", "", false)
+
+	tagsValue := propTable.propertyMap["Tags"]
+	if runeCount := len([]rune(tagsValue)); runeCount > propTable.maxValueLen {
+		t.Fatalf("expected Tags to be truncated to at most %d characters, got %d", propTable.maxValueLen, runeCount)
+	}
+
+	if !strings.HasSuffix(tagsValue, "…") {
+		t.Fatalf("expected truncated Tags value to end with an ellipsis, got %q", tagsValue)
+	}
+}
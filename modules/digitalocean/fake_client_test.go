@@ -0,0 +1,35 @@
+package digitalocean
+
+import (
+	"context"
+
+	"github.com/digitalocean/godo"
+)
+
+// fakeDropletsService fakes just the godo.DropletsService methods this widget uses.
+// Embedding the (nil) interface satisfies the rest of the interface, panicking if a
+// test ever exercises a method nobody bothered to fake
+type fakeDropletsService struct {
+	godo.DropletsService
+
+	listFunc func(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error)
+}
+
+func (f *fakeDropletsService) List(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	return f.listFunc(ctx, opts)
+}
+
+// fakeClient fakes just enough of doClient for the tests in this package
+type fakeClient struct {
+	droplets godo.DropletsService
+}
+
+func (c fakeClient) Actions() godo.ActionsService                     { return nil }
+func (c fakeClient) Droplets() godo.DropletsService                   { return c.droplets }
+func (c fakeClient) DropletActions() godo.DropletActionsService       { return nil }
+func (c fakeClient) Sizes() godo.SizesService                         { return nil }
+func (c fakeClient) FloatingIPs() godo.FloatingIPsService             { return nil }
+func (c fakeClient) FloatingIPActions() godo.FloatingIPActionsService { return nil }
+func (c fakeClient) Monitoring() godo.MonitoringService               { return nil }
+func (c fakeClient) Storage() godo.StorageService                     { return nil }
+func (c fakeClient) Tags() godo.TagsService                           { return nil }
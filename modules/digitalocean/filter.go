@@ -0,0 +1,32 @@
+package digitalocean
+
+import "github.com/gdamore/tcell"
+
+// startFilter enters filter mode and redraws immediately so the filter prompt in the
+// title shows up before the first keystroke
+func (widget *Widget) startFilter() {
+	widget.StartFilter()
+	widget.display()
+}
+
+// InputCapture intercepts keystrokes while filter mode is active, routing them into the
+// filter query instead of the widget's normal keyboard bindings. Outside of filter mode, it
+// defers to the embedded KeyboardWidget's bindings.
+func (widget *Widget) InputCapture(event *tcell.EventKey) *tcell.EventKey {
+	if event == nil || !widget.IsFiltering() {
+		return widget.KeyboardWidget.InputCapture(event)
+	}
+
+	switch event.Key() {
+	case tcell.KeyEscape:
+		widget.StopFilter()
+	case tcell.KeyEnter:
+		widget.ConfirmFilter()
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		widget.BackspaceFilter()
+	case tcell.KeyRune:
+		widget.AppendFilterRune(event.Rune())
+	}
+
+	return nil
+}
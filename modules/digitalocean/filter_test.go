@@ -0,0 +1,46 @@
+package digitalocean
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/gdamore/tcell"
+)
+
+func Test_InputCapture_enterConfirmsFilterWithoutClearing(t *testing.T) {
+	widget := &Widget{droplets: []godo.Droplet{{Name: "web-1"}, {Name: "db-1"}}}
+	widget.SetRenderFunction(func() {})
+	widget.SetFilterFunction(widget.dropletMatchesFilter)
+
+	widget.StartFilter()
+	widget.AppendFilterRune('w')
+	widget.AppendFilterRune('e')
+	widget.AppendFilterRune('b')
+
+	widget.InputCapture(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+
+	if widget.IsFiltering() {
+		t.Errorf("expected Enter to exit filter-entry mode")
+	}
+	if widget.FilterText() != "web" {
+		t.Errorf("expected Enter to keep the filter query, got %q", widget.FilterText())
+	}
+}
+
+func Test_InputCapture_escapeClearsFilter(t *testing.T) {
+	widget := &Widget{droplets: []godo.Droplet{{Name: "web-1"}, {Name: "db-1"}}}
+	widget.SetRenderFunction(func() {})
+	widget.SetFilterFunction(widget.dropletMatchesFilter)
+
+	widget.StartFilter()
+	widget.AppendFilterRune('w')
+
+	widget.InputCapture(tcell.NewEventKey(tcell.KeyEscape, 0, tcell.ModNone))
+
+	if widget.IsFiltering() {
+		t.Errorf("expected Escape to exit filter-entry mode")
+	}
+	if widget.FilterText() != "" {
+		t.Errorf("expected Escape to clear the filter query, got %q", widget.FilterText())
+	}
+}
@@ -0,0 +1,120 @@
+package digitalocean
+
+import "github.com/digitalocean/godo"
+
+// floatingIP pairs a godo.FloatingIP with the account label it belongs to, the same way
+// droplets are paired with accounts via widget.accounts
+type floatingIP struct {
+	godo.FloatingIP
+	account string
+}
+
+// toggleFloatingIPsView switches between the normal droplet list and an alternate view
+// listing reserved (DigitalOcean still calls this API "floating") IPs. Entering the
+// alternate view remembers which droplet was selected, so 'a' can assign the selected
+// floating IP back to it.
+func (widget *Widget) toggleFloatingIPsView() {
+	widget.showFloatingIPs = !widget.showFloatingIPs
+
+	if widget.showFloatingIPs {
+		widget.rememberedDropletIdx = widget.Selected
+		widget.fetchFloatingIPs()
+	} else {
+		widget.Selected = widget.rememberedDropletIdx
+		widget.SetItemCount(len(widget.droplets))
+	}
+
+	widget.display()
+}
+
+// fetchFloatingIPs fetches the floating/reserved IPs for every configured account and
+// stores them for display
+func (widget *Widget) fetchFloatingIPs() {
+	ips := []floatingIP{}
+
+	for label, client := range widget.clients {
+		ctx, cancel := widget.context()
+		list, _, err := client.FloatingIPs().List(ctx, nil)
+		cancel()
+
+		if err != nil {
+			widget.err = err
+			continue
+		}
+
+		for _, ip := range list {
+			ips = append(ips, floatingIP{FloatingIP: ip, account: label})
+		}
+	}
+
+	widget.floatingIPs = ips
+	widget.SetItemCount(len(widget.floatingIPs))
+}
+
+// currentFloatingIP returns the currently-selected floating IP, or nil if none is selected
+func (widget *Widget) currentFloatingIP() *floatingIP {
+	if !widget.showFloatingIPs || widget.Selected < 0 || widget.Selected >= len(widget.floatingIPs) {
+		return nil
+	}
+
+	return &widget.floatingIPs[widget.Selected]
+}
+
+// rememberedDroplet returns the droplet that was selected before switching into the
+// floating IPs view, or nil if there isn't one
+func (widget *Widget) rememberedDroplet() *godo.Droplet {
+	if widget.rememberedDropletIdx < 0 || widget.rememberedDropletIdx >= len(widget.droplets) {
+		return nil
+	}
+
+	return &widget.droplets[widget.rememberedDropletIdx]
+}
+
+// assignFloatingIP assigns the currently-selected floating IP to the droplet that was
+// selected before entering the floating IPs view. No-ops outside that view.
+func (widget *Widget) assignFloatingIP() {
+	ip := widget.currentFloatingIP()
+	droplet := widget.rememberedDroplet()
+	if ip == nil || droplet == nil {
+		return
+	}
+
+	client := widget.clientFor(ip.account)
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	if _, _, err := client.FloatingIPActions().Assign(ctx, ip.IP, droplet.ID); err != nil {
+		widget.showTransientMessage(err.Error())
+		return
+	}
+
+	widget.fetchFloatingIPs()
+}
+
+// unassignFloatingIP unassigns the currently-selected floating IP from whichever droplet
+// it's attached to. No-ops outside the floating IPs view.
+func (widget *Widget) unassignFloatingIP() {
+	ip := widget.currentFloatingIP()
+	if ip == nil {
+		return
+	}
+
+	client := widget.clientFor(ip.account)
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	if _, _, err := client.FloatingIPActions().Unassign(ctx, ip.IP); err != nil {
+		widget.showTransientMessage(err.Error())
+		return
+	}
+
+	widget.fetchFloatingIPs()
+}
@@ -7,15 +7,36 @@ func (widget *Widget) initializeKeyboardControls() {
 
 	widget.SetKeyboardChar("?", widget.showInfo, "Show info about the selected droplet")
 
-	widget.SetKeyboardChar("b", widget.dropletRestart, "Reboot the selected droplet")
+	widget.SetKeyboardChar("a", widget.assignFloatingIP, "Assign the selected reserved IP to the previously-selected droplet")
+	widget.SetKeyboardChar("b", widget.dropletRestart, "Reboot the selected droplet(s)")
+	widget.SetKeyboardChar("c", widget.cycleSortField, "Cycle the droplet list sort field")
+	widget.SetKeyboardChar("x", widget.copyDropletIP, "Copy the selected droplet's IP address to the clipboard")
+	widget.SetKeyboardChar("i", widget.toggleFloatingIPsView, "Toggle the reserved IPs view")
 	widget.SetKeyboardChar("j", widget.Prev, "Select previous item")
 	widget.SetKeyboardChar("k", widget.Next, "Select next item")
+	widget.SetKeyboardChar("l", widget.copyVisibleList, "Copy the currently-visible droplet list to the clipboard")
+	widget.SetKeyboardChar("o", widget.dropletPowerOn, "Power on the selected droplet")
 	widget.SetKeyboardChar("p", widget.dropletEnabledPrivateNetworking, "Enable private networking for the selected drople")
-	widget.SetKeyboardChar("s", widget.dropletShutDown, "Shut down the selected droplet")
+	widget.SetKeyboardChar("r", widget.dropletResize, "Resize the selected droplet")
+	widget.SetKeyboardChar("e", widget.sshDroplet, "SSH into the selected droplet")
+	widget.SetKeyboardChar("f", widget.startFilter, "Filter the droplet list by name")
+	widget.SetKeyboardChar("g", widget.tagAdd, "Add a tag to the selected droplet(s)")
+	widget.SetKeyboardChar("n", widget.tagRemove, "Remove a tag from the selected droplet(s)")
+	widget.SetKeyboardChar("h", widget.showActionHistory, "Show the selected droplet's recent action history")
+	widget.SetKeyboardChar("s", widget.dropletShutDown, "Shut down the selected droplet(s)")
+	widget.SetKeyboardChar(" ", widget.toggleDropletSelection, "Toggle multi-select on the highlighted droplet")
+	widget.SetKeyboardChar("t", widget.cycleTagFilter, "Cycle through the configured tag filters")
+	widget.SetKeyboardChar("v", widget.toggleSortDirection, "Reverse the droplet list sort order")
+	widget.SetKeyboardChar("w", widget.openDropletConsole, "Open the selected droplet in the DigitalOcean web console")
+	widget.SetKeyboardChar("y", widget.unassignFloatingIP, "Unassign the selected reserved IP")
+	widget.SetKeyboardChar("z", widget.dropletSnapshot, "Snapshot the selected droplet")
 	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
 
-	widget.SetKeyboardKey(tcell.KeyCtrlD, widget.dropletDestroy, "Destroy the selected droplet")
+	widget.SetPrimaryAction(widget.showInfo, "Show info about the selected droplet")
+
+	widget.SetKeyboardKey(tcell.KeyCtrlD, widget.dropletDestroy, "Destroy the selected droplet(s)")
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
-	widget.SetKeyboardKey(tcell.KeyEnter, widget.showInfo, "Show info about the selected droplet")
+	widget.SetKeyboardKey(tcell.KeyPgDn, widget.NextPage, "Select the item a page down")
+	widget.SetKeyboardKey(tcell.KeyPgUp, widget.PrevPage, "Select the item a page up")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
 }
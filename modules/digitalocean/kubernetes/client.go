@@ -0,0 +1,17 @@
+package kubernetes
+
+import "github.com/digitalocean/godo"
+
+// doClient is the subset of a godo.Client's API this widget uses, abstracted behind an
+// interface the same way modules/digitalocean does, so a fake can be swapped in for tests
+type doClient interface {
+	Kubernetes() godo.KubernetesService
+}
+
+// realClient adapts a *godo.Client, whose services are exported fields, to the
+// method-based doClient interface
+type realClient struct {
+	client *godo.Client
+}
+
+func (c realClient) Kubernetes() godo.KubernetesService { return c.client.Kubernetes }
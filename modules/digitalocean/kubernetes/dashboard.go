@@ -0,0 +1,22 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+// dashboardURL returns the DigitalOcean web console URL for the given cluster
+func dashboardURL(clusterID string) string {
+	return fmt.Sprintf("https://cloud.digitalocean.com/kubernetes/clusters/%s", clusterID)
+}
+
+// openClusterDashboard opens the selected cluster's page in the DigitalOcean web console
+func (widget *Widget) openClusterDashboard() {
+	cluster := widget.currentCluster()
+	if cluster == nil {
+		return
+	}
+
+	utils.OpenFile(dashboardURL(cluster.ID))
+}
@@ -0,0 +1,56 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		title = fmt.Sprintf("%s [red]![white]", title)
+		return title, widget.err.Error(), true
+	}
+
+	title = fmt.Sprintf("%s (%d)", title, len(widget.clusters))
+
+	if widget.Refreshing() {
+		title = fmt.Sprintf("%s (refreshing…)", title)
+
+		if len(widget.clusters) == 0 {
+			return title, " Loading…\n", false
+		}
+	}
+
+	str := fmt.Sprintf(
+		" [%s]Clusters\n\n",
+		widget.settings.common.Colors.Subheading,
+	)
+
+	for idx, cluster := range widget.clusters {
+		status := "unknown"
+		if cluster.Status != nil {
+			status = string(cluster.Status.State)
+		}
+
+		row := fmt.Sprintf(
+			"[%s]%-24s %-8s %-10s %-3d %s",
+			widget.RowColor(idx),
+			cluster.Name,
+			cluster.RegionSlug,
+			cluster.VersionSlug,
+			nodeCount(cluster),
+			status,
+		)
+
+		str += utils.HighlightableHelper(widget.View, row, idx, 24)
+	}
+
+	return title, str, false
+}
+
+func (widget *Widget) display() {
+	widget.ScrollableWidget.Redraw(widget.content)
+}
@@ -0,0 +1,15 @@
+package kubernetes
+
+import "github.com/gdamore/tcell"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeCommonControls(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Prev, "Select previous item")
+	widget.SetKeyboardChar("k", widget.Next, "Select next item")
+	widget.SetKeyboardChar("o", widget.openClusterDashboard, "Open the selected cluster in the DigitalOcean web console")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.openClusterDashboard, "Open the selected cluster in the DigitalOcean web console")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
+}
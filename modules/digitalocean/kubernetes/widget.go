@@ -0,0 +1,197 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+	"golang.org/x/oauth2"
+)
+
+/* -------------------- Oauth2 Token -------------------- */
+
+type tokenSource struct {
+	AccessToken string
+}
+
+// Token creates and returns an Oauth2 token
+func (t *tokenSource) Token() (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: t.AccessToken}, nil
+}
+
+/* -------------------- Widget -------------------- */
+
+// Widget is the container for DigitalOcean Kubernetes cluster data
+type Widget struct {
+	view.KeyboardWidget
+	view.ScrollableWidget
+
+	app      *tview.Application
+	client   doClient
+	clusters []*godo.KubernetesCluster
+	pages    *tview.Pages
+	settings *Settings
+	err      error
+}
+
+// NewWidget creates a new instance of a widget
+func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
+		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
+
+		app:      app,
+		pages:    pages,
+		settings: settings,
+	}
+
+	widget.initializeKeyboardControls()
+	widget.View.SetInputCapture(widget.InputCapture)
+	widget.View.SetScrollable(true)
+
+	widget.KeyboardWidget.SetView(widget.View)
+	widget.SetRenderFunction(widget.display)
+
+	widget.createClient()
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Fetch retrieves the DigitalOcean Kubernetes cluster list
+func (widget *Widget) Fetch() error {
+	if widget.client == nil {
+		return errors.New("no DigitalOcean apiKey configured")
+	}
+
+	clusters, err := widget.clustersFetch()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("timed out talking to DigitalOcean after %ds", widget.settings.timeout)
+		}
+
+		if errResp, ok := err.(*godo.ErrorResponse); ok {
+			return utils.AuthHintError(fmt.Errorf("DigitalOcean returned %d: %s", errResp.Response.StatusCode, errResp.Message), errResp.Response.StatusCode)
+		}
+
+		return err
+	}
+
+	widget.clusters = clusters
+
+	return nil
+}
+
+// HelpText returns the help text for this widget
+func (widget *Widget) HelpText() string {
+	return widget.KeyboardWidget.HelpText()
+}
+
+// Next selects the next item in the list
+func (widget *Widget) Next() {
+	widget.ScrollableWidget.Next()
+}
+
+// Prev selects the previous item in the list
+func (widget *Widget) Prev() {
+	widget.ScrollableWidget.Prev()
+}
+
+// Refresh updates the data for this widget and displays it onscreen
+func (widget *Widget) Refresh() {
+	widget.display()
+
+	err := widget.Fetch()
+	if err != nil {
+		widget.err = err
+		widget.SetItemCount(0)
+	} else {
+		widget.err = nil
+		widget.SetItemCount(len(widget.clusters))
+	}
+
+	widget.display()
+}
+
+// RefreshError returns the error from the widget's most recent refresh, or nil if it
+// succeeded. The scheduler uses this to back off refreshing while DigitalOcean is
+// erroring, instead of retrying at the normal interval.
+func (widget *Widget) RefreshError() error {
+	return widget.err
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// createClient creates a persistent DigitalOcean client for use in the calls below.
+func (widget *Widget) createClient() {
+	if widget.settings.apiKey == "" {
+		return
+	}
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, utils.NewProxiedHTTPClient(""))
+	oauthClient := oauth2.NewClient(ctx, &tokenSource{AccessToken: widget.settings.apiKey})
+	widget.client = realClient{client: godo.NewClient(oauthClient)}
+}
+
+// context returns a context bounded by the configured API call timeout
+func (widget *Widget) context() (context.Context, context.CancelFunc) {
+	timeout := time.Duration(widget.settings.timeout) * time.Second
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// currentCluster returns the currently-selected cluster, if there is one
+func (widget *Widget) currentCluster() *godo.KubernetesCluster {
+	if widget.Selected < 0 || widget.Selected >= len(widget.clusters) {
+		return nil
+	}
+
+	return widget.clusters[widget.Selected]
+}
+
+// clustersFetch retrieves every Kubernetes cluster for the configured account, paging
+// through results the same way modules/digitalocean's dropletsFetch does
+func (widget *Widget) clustersFetch() ([]*godo.KubernetesCluster, error) {
+	clusterList := []*godo.KubernetesCluster{}
+	opts := &godo.ListOptions{}
+
+	for {
+		ctx, cancel := widget.context()
+		clusters, resp, err := widget.client.Kubernetes().List(ctx, opts)
+		cancel()
+
+		if err != nil {
+			return clusterList, err
+		}
+
+		clusterList = append(clusterList, clusters...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return clusterList, err
+		}
+
+		opts.Page = page + 1
+	}
+
+	return clusterList, nil
+}
+
+// nodeCount returns the total number of nodes across every node pool in the cluster
+func nodeCount(cluster *godo.KubernetesCluster) int {
+	count := 0
+	for _, pool := range cluster.NodePools {
+		count += pool.Count
+	}
+
+	return count
+}
@@ -0,0 +1,19 @@
+package loadbalancers
+
+import "github.com/digitalocean/godo"
+
+// doClient is the subset of a godo.Client's API this widget uses, abstracted behind an
+// interface the same way modules/digitalocean does, so a fake can be swapped in for tests
+type doClient interface {
+	LoadBalancers() godo.LoadBalancersService
+	Droplets() godo.DropletsService
+}
+
+// realClient adapts a *godo.Client, whose services are exported fields, to the
+// method-based doClient interface
+type realClient struct {
+	client *godo.Client
+}
+
+func (c realClient) LoadBalancers() godo.LoadBalancersService { return c.client.LoadBalancers }
+func (c realClient) Droplets() godo.DropletsService           { return c.client.Droplets }
@@ -0,0 +1,50 @@
+package loadbalancers
+
+import (
+	"fmt"
+
+	"github.com/wtfutil/wtf/utils"
+)
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		title = fmt.Sprintf("%s [red]![white]", title)
+		return title, widget.err.Error(), true
+	}
+
+	title = fmt.Sprintf("%s (%d)", title, len(widget.loadBalancers))
+
+	if widget.Refreshing() {
+		title = fmt.Sprintf("%s (refreshing…)", title)
+
+		if len(widget.loadBalancers) == 0 {
+			return title, " Loading…\n", false
+		}
+	}
+
+	str := fmt.Sprintf(
+		" [%s]Load Balancers\n\n",
+		widget.settings.common.Colors.Subheading,
+	)
+
+	for idx, lb := range widget.loadBalancers {
+		row := fmt.Sprintf(
+			"[%s]%-24s %-10s %-16s %d droplet(s)",
+			widget.RowColor(idx),
+			lb.Name,
+			lb.Status,
+			lb.Algorithm,
+			len(lb.DropletIDs),
+		)
+
+		str += utils.HighlightableHelper(widget.View, row, idx, 24)
+	}
+
+	return title, str, false
+}
+
+func (widget *Widget) display() {
+	widget.ScrollableWidget.Redraw(widget.content)
+}
@@ -0,0 +1,84 @@
+package loadbalancers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/wtfutil/wtf/view"
+)
+
+// attachedDroplets fetches the droplets currently attached to the given load balancer
+func (widget *Widget) attachedDroplets(lb *godo.LoadBalancer) ([]godo.Droplet, error) {
+	droplets := make([]godo.Droplet, 0, len(lb.DropletIDs))
+
+	for _, id := range lb.DropletIDs {
+		ctx, cancel := widget.context()
+		droplet, _, err := widget.client.Droplets().Get(ctx, id)
+		cancel()
+
+		if err != nil {
+			return droplets, err
+		}
+
+		droplets = append(droplets, *droplet)
+	}
+
+	return droplets, nil
+}
+
+// renderAttachedDroplets formats a load balancer's attached droplets as a table of name,
+// status, and region
+func renderAttachedDroplets(droplets []godo.Droplet) string {
+	if len(droplets) == 0 {
+		return "No droplets attached to this load balancer."
+	}
+
+	var out strings.Builder
+
+	for _, droplet := range droplets {
+		region := ""
+		if droplet.Region != nil {
+			region = droplet.Region.Slug
+		}
+
+		out.WriteString(fmt.Sprintf(
+			"[green]%-24s[white] %-10s %s\n",
+			droplet.Name,
+			droplet.Status,
+			region,
+		))
+	}
+
+	return out.String()
+}
+
+// showAttachedDroplets shows a modal window listing the selected load balancer's attached
+// droplets
+func (widget *Widget) showAttachedDroplets() {
+	lb := widget.currentLoadBalancer()
+	if lb == nil {
+		return
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage("attachedDroplets")
+		widget.app.SetFocus(widget.View)
+	}
+
+	droplets, err := widget.attachedDroplets(lb)
+	if err != nil {
+		widget.showTransientMessage(fmt.Sprintf("Could not fetch attached droplets: %v", err))
+		return
+	}
+
+	modal := view.NewBillboardModal(renderAttachedDroplets(droplets), closeFunc)
+	modal.SetTitle(fmt.Sprintf("  %s droplets  ", lb.Name))
+
+	widget.pages.AddPage("attachedDroplets", modal, false, true)
+	widget.app.SetFocus(modal)
+
+	widget.app.QueueUpdateDraw(func() {
+		widget.app.Draw()
+	})
+}
@@ -0,0 +1,15 @@
+package loadbalancers
+
+import "github.com/gdamore/tcell"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeCommonControls(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Prev, "Select previous item")
+	widget.SetKeyboardChar("k", widget.Next, "Select next item")
+	widget.SetKeyboardChar("?", widget.showAttachedDroplets, "Show the selected load balancer's attached droplets")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.showAttachedDroplets, "Show the selected load balancer's attached droplets")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
+}
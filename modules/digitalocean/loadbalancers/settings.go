@@ -0,0 +1,33 @@
+package loadbalancers
+
+import (
+	"os"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "DO Load Balancers"
+)
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	common *cfg.Common
+
+	apiKey  string `help:"Your DigitalOcean API key."`
+	timeout int    `help:"The number of seconds to wait for a DigitalOcean API call to complete before giving up." values:"A positive integer, 0..n." optional:"true"`
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := &Settings{
+		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		apiKey:  ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_DIGITALOCEAN_API_KEY"))),
+		timeout: ymlConfig.UInt("timeout", 10),
+	}
+
+	return settings
+}
@@ -0,0 +1,108 @@
+package digitalocean
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/godo"
+)
+
+// metricsWindow is how far back to look when averaging CPU and memory utilization -
+// long enough to smooth over a single noisy sample, short enough to stay "current"
+const metricsWindow = 5 * time.Minute
+
+// dropletMetrics fetches the droplet's average CPU and memory utilization over the last
+// metricsWindow from DigitalOcean's Monitoring API. Either return value is empty if it
+// couldn't be determined - e.g. monitoring isn't enabled on the droplet, or there isn't
+// yet enough data
+func (widget *Widget) dropletMetrics(droplet *godo.Droplet) (cpuUsage, memoryUsage string) {
+	client := widget.clientFor(widget.accountForDroplet(droplet))
+	if client == nil {
+		return "", ""
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	end := time.Now()
+	req := &godo.DropletMetricsRequest{
+		HostID: strconv.Itoa(droplet.ID),
+		Start:  end.Add(-metricsWindow),
+		End:    end,
+	}
+
+	if cpu, _, err := client.Monitoring().GetDropletCPU(ctx, req); err == nil {
+		cpuUsage = cpuUtilizationDisplay(cpu)
+	}
+
+	total, _, totalErr := client.Monitoring().GetDropletTotalMemory(ctx, req)
+	available, _, availableErr := client.Monitoring().GetDropletAvailableMemory(ctx, req)
+	if totalErr == nil && availableErr == nil {
+		memoryUsage = memoryUtilizationDisplay(total, available)
+	}
+
+	return cpuUsage, memoryUsage
+}
+
+// cpuUtilizationDisplay turns a GetDropletCPU response - a set of per-mode (idle, user,
+// system, ...) cumulative CPU-seconds counters - into a percent-busy string, by comparing
+// the change in the idle counter to the change in the summed counters across the window
+func cpuUtilizationDisplay(resp *godo.MetricsResponse) string {
+	if resp == nil {
+		return ""
+	}
+
+	var idleDelta, totalDelta float64
+
+	for _, stream := range resp.Data.Result {
+		if len(stream.Values) < 2 {
+			continue
+		}
+
+		first := float64(stream.Values[0].Value)
+		last := float64(stream.Values[len(stream.Values)-1].Value)
+		delta := last - first
+
+		totalDelta += delta
+		if stream.Metric["mode"] == "idle" {
+			idleDelta += delta
+		}
+	}
+
+	if totalDelta <= 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%.1f%%", 100*(1-idleDelta/totalDelta))
+}
+
+// memoryUtilizationDisplay turns GetDropletTotalMemory/GetDropletAvailableMemory responses
+// into a percent-used string, using the most recent sample of each
+func memoryUtilizationDisplay(total, available *godo.MetricsResponse) string {
+	totalBytes := latestSampleTotal(total)
+	if totalBytes <= 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%.1f%%", 100*(1-latestSampleTotal(available)/totalBytes))
+}
+
+// latestSampleTotal sums the most recent sample of every stream in resp, since a metric
+// like memory can be split across more than one labeled stream
+func latestSampleTotal(resp *godo.MetricsResponse) float64 {
+	if resp == nil {
+		return 0
+	}
+
+	var sum float64
+	for _, stream := range resp.Data.Result {
+		if len(stream.Values) == 0 {
+			continue
+		}
+
+		sum += float64(stream.Values[len(stream.Values)-1].Value)
+	}
+
+	return sum
+}
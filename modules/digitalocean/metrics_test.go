@@ -0,0 +1,66 @@
+package digitalocean
+
+import (
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+)
+
+func sampleStream(mode string, values ...float64) metrics.SampleStream {
+	stream := metrics.SampleStream{Values: make([]metrics.SamplePair, len(values))}
+	if mode != "" {
+		stream.Metric = metrics.Metric{"mode": metrics.LabelValue(mode)}
+	}
+
+	for i, v := range values {
+		stream.Values[i] = metrics.SamplePair{Value: metrics.SampleValue(v)}
+	}
+
+	return stream
+}
+
+func Test_cpuUtilizationDisplay(t *testing.T) {
+	resp := &godo.MetricsResponse{
+		Data: godo.MetricsData{
+			Result: []metrics.SampleStream{
+				sampleStream("idle", 100, 180),
+				sampleStream("user", 50, 70),
+			},
+		},
+	}
+
+	// idle grew by 80 out of a total growth of 100 (80 idle + 20 user), so 20% busy
+	if got, want := cpuUtilizationDisplay(resp), "20.0%"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func Test_cpuUtilizationDisplay_noData(t *testing.T) {
+	if got := cpuUtilizationDisplay(nil); got != "" {
+		t.Errorf("expected an empty string for a nil response, got %q", got)
+	}
+
+	if got := cpuUtilizationDisplay(&godo.MetricsResponse{}); got != "" {
+		t.Errorf("expected an empty string when there's no data to derive a delta from, got %q", got)
+	}
+}
+
+func Test_memoryUtilizationDisplay(t *testing.T) {
+	total := &godo.MetricsResponse{
+		Data: godo.MetricsData{Result: []metrics.SampleStream{sampleStream("", 1000)}},
+	}
+	available := &godo.MetricsResponse{
+		Data: godo.MetricsData{Result: []metrics.SampleStream{sampleStream("", 250)}},
+	}
+
+	if got, want := memoryUtilizationDisplay(total, available), "75.0%"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func Test_memoryUtilizationDisplay_noTotal(t *testing.T) {
+	if got := memoryUtilizationDisplay(nil, nil); got != "" {
+		t.Errorf("expected an empty string when the total is unknown, got %q", got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/wtf"
 )
 
@@ -17,8 +18,31 @@ const (
 type Settings struct {
 	common *cfg.Common
 
-	apiKey     string `help:"Your DigitalOcean API key."`
-	dateFormat string `help:"The format to display dates and times in."`
+	apiKey         string            `help:"Your DigitalOcean API key."`
+	apiKeys        map[string]string `help:"A map of label to DigitalOcean API key, for managing droplets across multiple accounts." values:"A map of strings to strings." optional:"true"`
+	cache          bool              `help:"Whether or not to cache droplet data to disk so the widget shows stale-but-useful data immediately on startup, before the first live fetch completes." values:"true or false" optional:"true"`
+	caFile         string            `help:"Path to a PEM-encoded CA certificate to trust when talking to a self-hosted DigitalOcean-compatible API gateway." optional:"true"`
+	confirmDestroy bool              `help:"Whether or not to show a confirmation prompt before destroying a droplet." values:"true or false" optional:"true"`
+	dateFormat     string            `help:"The format to display dates and times in."`
+	detailPane     bool              `help:"Whether or not to show a properties pane below the droplet list that follows the current selection, instead of opening an info modal." values:"true or false" optional:"true"`
+	groupBy        string            `help:"Group the droplet list under a header row per group." values:"tag" optional:"true"`
+	maxItems       int               `help:"The maximum number of droplets to fetch, across all configured accounts. 0 fetches every droplet." values:"A positive integer, 0..n." optional:"true"`
+	maxRetries     int               `help:"The number of times to retry a request that's been rate-limited before giving up." values:"A positive integer, 0..n." optional:"true"`
+	nameFilter     string            `help:"Only show droplets whose name matches this regular expression." optional:"true"`
+	proxy          string            `help:"The URL of an HTTP proxy to send DigitalOcean API requests through. Falls back to the HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables when unset." optional:"true"`
+	regionColors   map[string]string `help:"A map of region slug to tview color name, used to color-code each droplet's row by region." values:"A map of strings to strings." optional:"true"`
+	relativeTime   bool              `help:"Whether or not to show the droplet creation date as a relative age, like \"3 days ago\", instead of an absolute timestamp." values:"true or false" optional:"true"`
+	sortBy         string            `help:"The droplet property to sort the list by." values:"name, region, status, created" optional:"true"`
+	timeout        int               `help:"The number of seconds to wait for a DigitalOcean API call to complete before giving up." values:"A positive integer, 0..n." optional:"true"`
+	showCreated    bool              `help:"Whether or not to show each droplet's creation date in the list." values:"true or false" optional:"true"`
+	showIP         bool              `help:"Whether or not to show each droplet's public IPv4 address and region." values:"true or false" optional:"true"`
+	showMetrics    bool              `help:"Whether or not to show each droplet's latest CPU and memory usage in its info modal." values:"true or false" optional:"true"`
+	showRateLimit  bool              `help:"Whether or not to show each account's remaining API quota and reset time in a footer line." values:"true or false" optional:"true"`
+	showStatus     bool              `help:"Whether or not to show each droplet's status, color-coded by state." values:"true or false" optional:"true"`
+	skipVerify     bool              `help:"Whether or not to skip TLS certificate verification when talking to the DigitalOcean API. Only use this against a trusted internal endpoint." values:"true or false" optional:"true"`
+	sshUser        string            `help:"The user to SSH into a droplet as when using the SSH keybinding." optional:"true"`
+	tags           []string          `help:"Only show droplets carrying one of these tags. An empty list shows all droplets." values:"A list of strings." optional:"true"`
+	waitForActions bool              `help:"Whether or not to poll a triggered reboot/shutdown/resize until it completes, showing an in-progress indicator on the droplet's row, before refreshing." values:"true or false" optional:"true"`
 }
 
 // NewSettingsFromYAML creates a new settings instance from a YAML config block
@@ -27,9 +51,64 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	settings := Settings{
 		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
-		apiKey:     ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_DIGITALOCEAN_API_KEY"))),
-		dateFormat: ymlConfig.UString("dateFormat", wtf.DateFormat),
+		apiKey:         ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_DIGITALOCEAN_API_KEY"))),
+		cache:          ymlConfig.UBool("cache", false),
+		caFile:         ymlConfig.UString("caFile", ""),
+		confirmDestroy: ymlConfig.UBool("confirmDestroy", true),
+		dateFormat:     ymlConfig.UString("dateFormat", wtf.DateFormat),
+		detailPane:     ymlConfig.UBool("detailPane", false),
+		groupBy:        ymlConfig.UString("groupBy", ""),
+		maxItems:       ymlConfig.UInt("maxItems", 0),
+		maxRetries:     ymlConfig.UInt("maxRetries", 3),
+		nameFilter:     ymlConfig.UString("nameFilter", ""),
+		proxy:          ymlConfig.UString("proxy", ""),
+		regionColors:   stringMap(ymlConfig, "regionColors"),
+		relativeTime:   ymlConfig.UBool("relativeTime", false),
+		sortBy:         ymlConfig.UString("sortBy", "name"),
+		timeout:        ymlConfig.UInt("timeout", 10),
+		showCreated:    ymlConfig.UBool("showCreated", false),
+		showIP:         ymlConfig.UBool("showIP", true),
+		showMetrics:    ymlConfig.UBool("showMetrics", false),
+		showRateLimit:  ymlConfig.UBool("showRateLimit", false),
+		showStatus:     ymlConfig.UBool("showStatus", true),
+		skipVerify:     ymlConfig.UBool("skipVerify", false),
+		sshUser:        ymlConfig.UString("sshUser", "root"),
+		tags:           utils.ToStrs(ymlConfig.UList("tags", []interface{}{})),
+		waitForActions: ymlConfig.UBool("waitForActions", false),
 	}
 
+	settings.apiKeys = accountTokens(ymlConfig, settings.apiKey)
+
 	return &settings
 }
+
+// accountTokens builds a label-to-token map of DigitalOcean accounts out of the `apiKeys`
+// setting (a map of label to token) and, if present, the single `apiKey` setting (labeled
+// "default"), so the widget can manage droplets across more than one account.
+func accountTokens(ymlConfig *config.Config, apiKey string) map[string]string {
+	tokens := map[string]string{}
+
+	if apiKey != "" {
+		tokens["default"] = apiKey
+	}
+
+	for label, token := range stringMap(ymlConfig, "apiKeys") {
+		tokens[label] = token
+	}
+
+	return tokens
+}
+
+// stringMap reads a setting that's a map of string to string, discarding any entries
+// whose value isn't a string
+func stringMap(ymlConfig *config.Config, key string) map[string]string {
+	result := map[string]string{}
+
+	for k, v := range ymlConfig.UMap(key) {
+		if str, ok := v.(string); ok {
+			result[k] = str
+		}
+	}
+
+	return result
+}
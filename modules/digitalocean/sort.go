@@ -0,0 +1,130 @@
+package digitalocean
+
+import (
+	"sort"
+
+	"github.com/digitalocean/godo"
+)
+
+// sortFields are the droplet properties that the list can be sorted by, in the order
+// cycleSortField() cycles through them
+var sortFields = []string{"name", "region", "status", "created"}
+
+// sortDroplets sorts widget.droplets (and the parallel widget.accounts slice) in place
+// according to widget.sortField and widget.sortAsc. Callers that care about preserving the
+// current selection across the reorder should bracket this call with PinSelection/SetItems
+func (widget *Widget) sortDroplets() {
+	indices := make([]int, len(widget.droplets))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		groupI := widget.dropletGroup(widget.droplets[indices[i]])
+		groupJ := widget.dropletGroup(widget.droplets[indices[j]])
+		if groupI != groupJ {
+			return groupI < groupJ
+		}
+
+		less := dropletLess(widget.droplets[indices[i]], widget.droplets[indices[j]], widget.sortField)
+		if !widget.sortAsc {
+			return !less
+		}
+		return less
+	})
+
+	droplets := make([]godo.Droplet, len(widget.droplets))
+	accounts := make([]string, len(widget.accounts))
+
+	for newIdx, oldIdx := range indices {
+		droplets[newIdx] = widget.droplets[oldIdx]
+		if oldIdx < len(widget.accounts) {
+			accounts[newIdx] = widget.accounts[oldIdx]
+		}
+	}
+
+	widget.droplets = droplets
+	widget.accounts = accounts
+}
+
+// dropletKey returns a stable identity for the droplet at idx - its ID - for use with
+// ScrollableWidget's PinSelection/SetItems, so the selection follows a droplet across a
+// re-sort or refetch instead of sticking to a raw index that may now point elsewhere
+func (widget *Widget) dropletKey(idx int) interface{} {
+	return widget.droplets[idx].ID
+}
+
+// cycleSortField advances to the next sort field, re-sorts, and redraws
+func (widget *Widget) cycleSortField() {
+	for i, field := range sortFields {
+		if field == widget.sortField {
+			widget.sortField = sortFields[(i+1)%len(sortFields)]
+			break
+		}
+	}
+
+	widget.PinSelection(widget.dropletKey)
+	widget.sortDroplets()
+	widget.SetItems(len(widget.droplets), widget.dropletKey)
+
+	widget.display()
+}
+
+// toggleSortDirection flips between ascending and descending order, re-sorts, and redraws
+func (widget *Widget) toggleSortDirection() {
+	widget.sortAsc = !widget.sortAsc
+
+	widget.PinSelection(widget.dropletKey)
+	widget.sortDroplets()
+	widget.SetItems(len(widget.droplets), widget.dropletKey)
+
+	widget.display()
+}
+
+// dropletLess reports whether a should sort before b according to field
+func dropletLess(a, b godo.Droplet, field string) bool {
+	switch field {
+	case "region":
+		return dropletRegionSlug(a) < dropletRegionSlug(b)
+	case "status":
+		return a.Status < b.Status
+	case "created":
+		return a.Created < b.Created
+	default:
+		return a.Name < b.Name
+	}
+}
+
+// dropletRegionSlug returns a droplet's region slug, or "" if it has none
+func dropletRegionSlug(droplet godo.Droplet) string {
+	if droplet.Region == nil {
+		return ""
+	}
+	return droplet.Region.Slug
+}
+
+// dropletGroup returns the group header a droplet belongs under, according to
+// widget.settings.groupBy. An empty string means grouping is off, so every droplet
+// shares the same (empty) group and sortDroplets leaves the order untouched
+func (widget *Widget) dropletGroup(droplet godo.Droplet) string {
+	switch widget.settings.groupBy {
+	case "tag":
+		return dropletGroupTag(droplet)
+	default:
+		return ""
+	}
+}
+
+// dropletGroupTag returns the first tag a droplet carries, in sorted order, or
+// "untagged" if it has none
+func dropletGroupTag(droplet godo.Droplet) string {
+	if len(droplet.Tags) == 0 {
+		return "untagged"
+	}
+
+	tags := make([]string, len(droplet.Tags))
+	copy(tags, droplet.Tags)
+	sort.Strings(tags)
+
+	return tags[0]
+}
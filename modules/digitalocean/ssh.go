@@ -0,0 +1,35 @@
+package digitalocean
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// sshDroplet suspends the dashboard and execs an SSH session to the selected droplet's
+// public IPv4 (falling back to its private IPv4), restoring the dashboard cleanly when
+// the session ends
+func (widget *Widget) sshDroplet() {
+	currDroplet := widget.currentDroplet()
+	if currDroplet == nil {
+		return
+	}
+
+	ip, err := currDroplet.PublicIPv4()
+	if err != nil || ip == "" {
+		ip, err = currDroplet.PrivateIPv4()
+	}
+
+	if err != nil || ip == "" {
+		widget.showTransientMessage("No IP address found for this droplet")
+		return
+	}
+
+	widget.app.Suspend(func() {
+		cmd := exec.Command("ssh", fmt.Sprintf("%s@%s", widget.settings.sshUser, ip))
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		cmd.Run()
+	})
+}
@@ -0,0 +1,107 @@
+package digitalocean
+
+import (
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/rivo/tview"
+)
+
+// tagAdd opens a modal prompting for a tag name, then adds it to the selected droplet(s)
+func (widget *Widget) tagAdd() {
+	widget.promptForTag("Add tag", "addTag", func(client doClient, droplet *godo.Droplet, tag string) {
+		widget.tagDroplet(client, droplet, tag)
+	})
+}
+
+// tagRemove opens a modal prompting for a tag name, then removes it from the selected
+// droplet(s)
+func (widget *Widget) tagRemove() {
+	widget.promptForTag("Remove tag", "removeTag", func(client doClient, droplet *godo.Droplet, tag string) {
+		widget.untagDroplet(client, droplet, tag)
+	})
+}
+
+// promptForTag shows a modal prompting for a tag name, then applies action to the
+// currently-selected droplet(s), falling back to the highlighted droplet if none are
+// multi-selected
+func (widget *Widget) promptForTag(title, pageName string, action func(client doClient, droplet *godo.Droplet, tag string)) {
+	droplets := widget.selectedDroplets()
+	if len(droplets) == 0 {
+		return
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage(pageName)
+		widget.app.SetFocus(widget.View)
+	}
+
+	form := tview.NewForm()
+	form.AddInputField("Tag", "", 40, nil, nil)
+	form.AddButton("Apply", func() {
+		tag := form.GetFormItem(0).(*tview.InputField).GetText()
+		closeFunc()
+
+		if tag == "" {
+			return
+		}
+
+		for _, droplet := range droplets {
+			client := widget.clientFor(widget.accountForDroplet(droplet))
+			if client == nil {
+				continue
+			}
+
+			action(client, droplet, tag)
+		}
+
+		widget.Refresh()
+	})
+	form.AddButton("Cancel", closeFunc)
+	form.SetBorder(true)
+	form.SetTitle(fmt.Sprintf("  %s  ", title))
+
+	widget.pages.AddPage(pageName, form, true, true)
+	widget.app.SetFocus(form)
+}
+
+// tagDroplet adds tag to the given droplet, creating the tag first if it doesn't already
+// exist - DigitalOcean requires a tag to exist before it can be applied to a resource
+func (widget *Widget) tagDroplet(client doClient, droplet *godo.Droplet, tag string) {
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	client.Tags().Create(ctx, &godo.TagCreateRequest{Name: tag})
+
+	_, err := client.Tags().TagResources(ctx, tag, &godo.TagResourcesRequest{
+		Resources: []godo.Resource{
+			{ID: fmt.Sprintf("%d", droplet.ID), Type: godo.DropletResourceType},
+		},
+	})
+
+	if err != nil {
+		widget.showTransientMessage(fmt.Sprintf("Could not tag %s: %v", droplet.Name, err))
+		return
+	}
+
+	widget.showTransientMessage(fmt.Sprintf("Tagged %s with %q", droplet.Name, tag))
+}
+
+// untagDroplet removes tag from the given droplet
+func (widget *Widget) untagDroplet(client doClient, droplet *godo.Droplet, tag string) {
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	_, err := client.Tags().UntagResources(ctx, tag, &godo.UntagResourcesRequest{
+		Resources: []godo.Resource{
+			{ID: fmt.Sprintf("%d", droplet.ID), Type: godo.DropletResourceType},
+		},
+	})
+
+	if err != nil {
+		widget.showTransientMessage(fmt.Sprintf("Could not remove tag from %s: %v", droplet.Name, err))
+		return
+	}
+
+	widget.showTransientMessage(fmt.Sprintf("Removed tag %q from %s", tag, droplet.Name))
+}
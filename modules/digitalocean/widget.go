@@ -4,9 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/digitalocean/godo"
+	"github.com/gdamore/tcell"
 	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/logger"
 	"github.com/wtfutil/wtf/utils"
 	"github.com/wtfutil/wtf/view"
 	"golang.org/x/oauth2"
@@ -34,22 +40,85 @@ type Widget struct {
 	view.ScrollableWidget
 
 	app      *tview.Application
-	client   *godo.Client
+	clients  map[string]doClient
 	droplets []godo.Droplet
+	accounts []string
 	pages    *tview.Pages
 	settings *Settings
 	err      error
+
+	tagFilterIdx int
+
+	sortField string
+	sortAsc   bool
+
+	dataStale bool
+	dataAsOf  time.Time
+
+	showFloatingIPs      bool
+	floatingIPs          []floatingIP
+	rememberedDropletIdx int
+
+	volumeCache map[int][]godo.Volume
+
+	pendingActions map[int]string
+
+	rateLimits map[string]godo.Rate
+
+	nameFilter    *regexp.Regexp
+	nameFilterErr error
+
+	selectedIDs map[int]bool
+
+	truncated bool
+
+	hasRendered       bool
+	lastRenderedTitle string
+	lastRenderedBody  string
+	lastRenderedWrap  bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // NewWidget creates a new instance of a widget
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
+	ctx, cancel := context.WithCancel(context.Background())
+
 	widget := Widget{
+		ctx:    ctx,
+		cancel: cancel,
+
 		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
 		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
 
 		app:      app,
 		pages:    pages,
 		settings: settings,
+
+		tagFilterIdx: -1,
+
+		sortField: "name",
+		sortAsc:   true,
+
+		volumeCache: make(map[int][]godo.Volume),
+
+		pendingActions: make(map[int]string),
+
+		rateLimits: make(map[string]godo.Rate),
+
+		selectedIDs: make(map[int]bool),
+	}
+
+	for _, field := range sortFields {
+		if field == settings.sortBy {
+			widget.sortField = settings.sortBy
+			break
+		}
+	}
+
+	if settings.nameFilter != "" {
+		widget.nameFilter, widget.nameFilterErr = regexp.Compile(settings.nameFilter)
 	}
 
 	widget.initializeKeyboardControls()
@@ -59,8 +128,10 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 	widget.KeyboardWidget.SetView(widget.View)
 	widget.SetRenderFunction(widget.display)
+	widget.SetFilterFunction(widget.dropletMatchesFilter)
 
-	widget.createClient()
+	widget.createClients()
+	widget.loadCachedDroplets()
 
 	return &widget
 }
@@ -69,13 +140,39 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 // Fetch retrieves droplet data
 func (widget *Widget) Fetch() error {
-	if widget.client == nil {
+	if widget.nameFilterErr != nil {
+		return fmt.Errorf("invalid nameFilter: %v", widget.nameFilterErr)
+	}
+
+	if len(widget.clients) == 0 {
 		return errors.New("client could not be initialized")
 	}
 
-	var err error
-	widget.droplets, err = widget.dropletsFetch()
-	return err
+	droplets, accounts, err := widget.dropletsFetch()
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("timed out talking to DigitalOcean after %ds", widget.settings.timeout)
+		}
+
+		if errResp, ok := err.(*godo.ErrorResponse); ok {
+			return utils.AuthHintError(fmt.Errorf("DigitalOcean returned %d: %s", errResp.Response.StatusCode, errResp.Message), errResp.Response.StatusCode)
+		}
+
+		return err
+	}
+
+	widget.PinSelection(widget.dropletKey)
+	widget.droplets = droplets
+	widget.accounts = accounts
+	widget.sortDroplets()
+	widget.SetItems(len(widget.droplets), widget.dropletKey)
+
+	widget.dataStale = false
+	widget.dataAsOf = time.Now()
+	widget.cacheDroplets(widget.dataAsOf)
+	widget.volumeCache = make(map[int][]godo.Volume)
+
+	return nil
 }
 
 // HelpText returns the help text for this widget
@@ -93,65 +190,367 @@ func (widget *Widget) Prev() {
 	widget.ScrollableWidget.Prev()
 }
 
+// NextPage selects the item a page down from the current selection
+func (widget *Widget) NextPage() {
+	widget.ScrollableWidget.NextPage()
+}
+
+// PrevPage selects the item a page up from the current selection
+func (widget *Widget) PrevPage() {
+	widget.ScrollableWidget.PrevPage()
+}
+
 // Refresh updates the data for this widget and displays it onscreen
 func (widget *Widget) Refresh() {
+	widget.display()
+
 	err := widget.Fetch()
 	if err != nil {
 		widget.err = err
 		widget.SetItemCount(0)
 	} else {
 		widget.err = nil
-		widget.SetItemCount(len(widget.droplets))
 	}
 
 	widget.display()
 }
 
+// RefreshError returns the error from the widget's most recent refresh, or nil if it
+// succeeded. The scheduler uses this to back off refreshing while DigitalOcean is
+// erroring, instead of retrying at the normal interval.
+func (widget *Widget) RefreshError() error {
+	return widget.err
+}
+
+// AlertStatuses returns each droplet's current status, keyed by droplet name, so the
+// alerting system can evaluate a configured alertWhen rule (e.g. "status == off") against
+// every droplet, not just the currently selected one
+func (widget *Widget) AlertStatuses() map[string]string {
+	statuses := make(map[string]string, len(widget.droplets))
+
+	for _, droplet := range widget.droplets {
+		statuses[droplet.Name] = droplet.Status
+	}
+
+	return statuses
+}
+
 // Unselect clears the selection of list items
 func (widget *Widget) Unselect() {
 	widget.ScrollableWidget.Unselect()
 	widget.RenderFunction()
 }
 
+// Stop cancels any in-flight DigitalOcean API calls before stopping the widget's scheduler,
+// so a quit doesn't have to wait out a slow or hung refresh
+func (widget *Widget) Stop() {
+	widget.cancel()
+	widget.ScrollableWidget.Stop()
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
-// createClient create a persisten DigitalOcean client for use in the calls below
-func (widget *Widget) createClient() {
-	tokenSource := &tokenSource{
-		AccessToken: widget.settings.apiKey,
+// createClients creates a persistent DigitalOcean client per configured account, keyed by label,
+// for use in the calls below. Requests go through a proxy-aware HTTP client so the widget
+// works behind a corporate HTTPS_PROXY, or the configured proxy setting if one is given
+func (widget *Widget) createClients() {
+	widget.clients = make(map[string]doClient, len(widget.settings.apiKeys))
+
+	httpClient := utils.NewHTTPClient(widget.settings.proxy, widget.settings.caFile, widget.settings.skipVerify)
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+
+	for label, apiKey := range widget.settings.apiKeys {
+		tokenSource := &tokenSource{
+			AccessToken: apiKey,
+		}
+
+		oauthClient := oauth2.NewClient(ctx, tokenSource)
+		widget.clients[label] = realClient{client: godo.NewClient(oauthClient)}
+	}
+}
+
+// currentAccount returns the label of the currently-selected droplet's account, if there is one
+func (widget *Widget) currentAccount() string {
+	if len(widget.accounts) <= widget.Selected {
+		return ""
+	}
+
+	return widget.accounts[widget.Selected]
+}
+
+// accountForDroplet returns the label of the account that owns the given droplet, found by
+// matching its ID against the widget's droplet list, or "" if it's not found
+func (widget *Widget) accountForDroplet(droplet *godo.Droplet) string {
+	for idx := range widget.droplets {
+		if widget.droplets[idx].ID != droplet.ID {
+			continue
+		}
+
+		if idx < len(widget.accounts) {
+			return widget.accounts[idx]
+		}
+
+		break
 	}
 
-	oauthClient := oauth2.NewClient(context.Background(), tokenSource)
-	widget.client = godo.NewClient(oauthClient)
+	return ""
+}
+
+// clientFor returns the client for the given account label, or nil if there isn't one
+func (widget *Widget) clientFor(account string) doClient {
+	return widget.clients[account]
+}
+
+// context returns a context bounded by the configured API call timeout, and cancelled
+// early if the widget is stopped
+func (widget *Widget) context() (context.Context, context.CancelFunc) {
+	timeout := time.Duration(widget.settings.timeout) * time.Second
+	return context.WithTimeout(widget.ctx, timeout)
+}
+
+// dropletMatchesFilter returns true if the droplet at idx's name contains filterText,
+// case-insensitively. It's passed to SetFilterFunction so the "f" key's filtering narrows the
+// droplet list by name.
+func (widget *Widget) dropletMatchesFilter(idx int, filterText string) bool {
+	if idx < 0 || idx >= len(widget.droplets) {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(widget.droplets[idx].Name), strings.ToLower(filterText))
 }
 
 // currentDroplet returns the currently-selected droplet, if there is one
 // Returns nil if no droplet is selected
 func (widget *Widget) currentDroplet() *godo.Droplet {
-	if len(widget.droplets) == 0 {
+	if widget.Selected < 0 || widget.Selected >= len(widget.droplets) {
 		return nil
 	}
 
-	if len(widget.droplets) <= widget.Selected {
-		return nil
+	return &widget.droplets[widget.Selected]
+}
+
+// toggleDropletSelection adds the currently-highlighted droplet to the multi-selection set
+// used by the bulk actions below, or removes it if it's already in the set
+func (widget *Widget) toggleDropletSelection() {
+	currDroplet := widget.currentDroplet()
+	if currDroplet == nil {
+		return
 	}
 
-	return &widget.droplets[widget.Selected]
+	if widget.selectedIDs[currDroplet.ID] {
+		delete(widget.selectedIDs, currDroplet.ID)
+	} else {
+		widget.selectedIDs[currDroplet.ID] = true
+	}
+
+	widget.display()
 }
 
+// selectedDroplets returns the droplets in the multi-selection set, in list order. If the
+// set is empty, it falls back to just the currently-highlighted droplet, so the bulk actions
+// below degrade to their original single-droplet behavior when nothing's been multi-selected
+func (widget *Widget) selectedDroplets() []*godo.Droplet {
+	if len(widget.selectedIDs) == 0 {
+		currDroplet := widget.currentDroplet()
+		if currDroplet == nil {
+			return nil
+		}
+
+		return []*godo.Droplet{currDroplet}
+	}
+
+	selected := []*godo.Droplet{}
+	for idx := range widget.droplets {
+		droplet := &widget.droplets[idx]
+		if widget.selectedIDs[droplet.ID] {
+			selected = append(selected, droplet)
+		}
+	}
+
+	return selected
+}
+
+// clearSelection empties the multi-selection set
+func (widget *Widget) clearSelection() {
+	widget.selectedIDs = make(map[int]bool)
+}
+
+// transientRetries is how many extra times dropletsFetch retries an account's fetch
+// after a non-rate-limit failure, such as a DNS hiccup or connection reset
+const transientRetries = 2
+
 // dropletsFetch uses the DigitalOcean API to fetch information about all the available droplets
-func (widget *Widget) dropletsFetch() ([]godo.Droplet, error) {
+// across all configured accounts. It backs off when DigitalOcean's rate limit is running low,
+// and retries with exponential backoff when it's hit a 429, up to widget.settings.maxRetries
+// times. Transient failures that aren't rate-limit related get their own jittered retry via
+// utils.RetryWithJitter, so a flaky connection doesn't blank out the widget until the next
+// refresh interval. If retries are exhausted, whatever droplets have been collected so far are
+// returned alongside the error. The returned account slice is parallel to the droplet slice,
+// and records which account's client should be used to act on that droplet.
+func (widget *Widget) dropletsFetch() ([]godo.Droplet, []string, error) {
+	dropletList := []godo.Droplet{}
+	accountList := []string{}
+	widget.truncated = false
+
+	for label, client := range widget.clients {
+		remaining := widget.settings.maxItems
+		if remaining > 0 {
+			remaining -= len(dropletList)
+			if remaining <= 0 {
+				widget.truncated = true
+				break
+			}
+		}
+
+		var droplets []godo.Droplet
+		var rate godo.Rate
+		var err error
+
+		logger.Debug("digitalocean: fetching droplets for account %q", label)
+
+		retryErr := utils.RetryWithJitter(widget.ctx, transientRetries, time.Second, func() error {
+			droplets, rate, err = widget.accountDropletsFetch(client, remaining)
+			return err
+		})
+		if retryErr != nil {
+			err = retryErr
+		}
+
+		if widget.settings.maxItems > 0 && len(droplets) >= remaining {
+			widget.truncated = true
+			droplets = droplets[:remaining]
+		}
+
+		if widget.settings.showRateLimit {
+			widget.rateLimits[label] = rate
+		}
+
+		logger.Debug("digitalocean: account %q returned %d droplet(s)", label, len(droplets))
+
+		droplets = widget.filterByTag(droplets)
+		droplets = widget.filterByName(droplets)
+
+		for range droplets {
+			accountList = append(accountList, label)
+		}
+		dropletList = append(dropletList, droplets...)
+
+		if err != nil {
+			logger.Error("digitalocean: failed to fetch droplets for account %q: %v", label, err)
+			return dropletList, accountList, err
+		}
+	}
+
+	return dropletList, accountList, nil
+}
+
+// activeTags returns the tags the droplet list should currently be filtered to, if any.
+// With no active runtime filter, that's every tag configured in the `tags` setting; with
+// one active, it's just that single tag
+func (widget *Widget) activeTags() []string {
+	if widget.tagFilterIdx < 0 || widget.tagFilterIdx >= len(widget.settings.tags) {
+		return widget.settings.tags
+	}
+
+	return []string{widget.settings.tags[widget.tagFilterIdx]}
+}
+
+// filterByTag returns only the droplets carrying at least one of the active tags. An empty
+// tag filter preserves the current behavior of showing every droplet
+func (widget *Widget) filterByTag(droplets []godo.Droplet) []godo.Droplet {
+	tags := widget.activeTags()
+	if len(tags) == 0 {
+		return droplets
+	}
+
+	filtered := []godo.Droplet{}
+	for _, droplet := range droplets {
+		if dropletHasAnyTag(droplet, tags) {
+			filtered = append(filtered, droplet)
+		}
+	}
+
+	return filtered
+}
+
+// filterByName returns only the droplets whose name matches the configured nameFilter regex.
+// With no nameFilter configured, every droplet is returned unchanged
+func (widget *Widget) filterByName(droplets []godo.Droplet) []godo.Droplet {
+	if widget.nameFilter == nil {
+		return droplets
+	}
+
+	filtered := []godo.Droplet{}
+	for _, droplet := range droplets {
+		if widget.nameFilter.MatchString(droplet.Name) {
+			filtered = append(filtered, droplet)
+		}
+	}
+
+	return filtered
+}
+
+// dropletHasAnyTag returns true if the droplet carries at least one of the given tags
+func dropletHasAnyTag(droplet godo.Droplet, tags []string) bool {
+	for _, dropletTag := range droplet.Tags {
+		for _, tag := range tags {
+			if dropletTag == tag {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// cycleTagFilter advances to the next single-tag filter, wrapping around to "all configured
+// tags" after the last one
+func (widget *Widget) cycleTagFilter() {
+	if len(widget.settings.tags) == 0 {
+		return
+	}
+
+	widget.tagFilterIdx++
+	if widget.tagFilterIdx >= len(widget.settings.tags) {
+		widget.tagFilterIdx = -1
+	}
+
+	widget.Refresh()
+}
+
+// currentPage returns the 1-indexed page opts is currently set to request, since
+// godo's ListOptions defaults Page to 0 for "the first page"
+func currentPage(opts *godo.ListOptions) int {
+	if opts.Page <= 0 {
+		return 1
+	}
+
+	return opts.Page
+}
+
+// accountDropletsFetch fetches pages of droplets for a single account's client, along with
+// the rate limit status reported on the last page fetched. maxItems, if greater than 0,
+// stops fetching further pages once that many droplets have been collected, saving the
+// remaining API calls.
+func (widget *Widget) accountDropletsFetch(client doClient, maxItems int) ([]godo.Droplet, godo.Rate, error) {
 	dropletList := []godo.Droplet{}
 	opts := &godo.ListOptions{}
+	rate := godo.Rate{}
 
 	for {
-		droplets, resp, err := widget.client.Droplets.List(context.Background(), opts)
+		droplets, resp, err := widget.dropletsListWithRetry(client, opts)
 		if err != nil {
-			return dropletList, err
+			return dropletList, rate, err
 		}
 
-		for _, d := range droplets {
-			dropletList = append(dropletList, d)
+		rate = resp.Rate
+
+		logger.Debug("digitalocean: fetched page %d (%d droplet(s))", currentPage(opts), len(droplets))
+
+		dropletList = append(dropletList, droplets...)
+
+		if maxItems > 0 && len(dropletList) >= maxItems {
+			break
 		}
 
 		if resp.Links == nil || resp.Links.IsLastPage() {
@@ -160,31 +559,116 @@ func (widget *Widget) dropletsFetch() ([]godo.Droplet, error) {
 
 		page, err := resp.Links.CurrentPage()
 		if err != nil {
-			return dropletList, err
+			return dropletList, rate, err
 		}
 
+		widget.dropletsRateLimitBackoff(resp)
+
 		// Set the page we want for the next request
 		opts.Page = page + 1
 	}
 
-	return dropletList, nil
+	return dropletList, rate, nil
+}
+
+// dropletsListWithRetry lists a single page of droplets, retrying with exponential backoff
+// if DigitalOcean responds with a 429 (too many requests)
+func (widget *Widget) dropletsListWithRetry(client doClient, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+	var droplets []godo.Droplet
+	var resp *godo.Response
+	var err error
+
+	backoff := time.Second
+
+	for attempt := 0; attempt <= widget.settings.maxRetries; attempt++ {
+		ctx, cancel := widget.context()
+		droplets, resp, err = client.Droplets().List(ctx, opts)
+		cancel()
+
+		if err == nil {
+			return droplets, resp, nil
+		}
+
+		if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+			return droplets, resp, err
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+
+	return droplets, resp, err
+}
+
+// dropletsRateLimitBackoff pauses until DigitalOcean's rate limit resets when the
+// number of remaining requests is running low
+func (widget *Widget) dropletsRateLimitBackoff(resp *godo.Response) {
+	const lowRemaining = 5
+
+	if resp.Rate.Remaining > lowRemaining {
+		return
+	}
+
+	wait := time.Until(resp.Rate.Reset.Time)
+	if wait > 0 {
+		time.Sleep(wait)
+	}
 }
 
 /* -------------------- Droplet Actions -------------------- */
 
-// dropletDestroy destroys the selected droplet
+// dropletDestroy destroys the selected droplet(s), showing a confirmation modal first if
+// the confirmDestroy setting is enabled, or unconditionally if more than one is selected
 func (widget *Widget) dropletDestroy() {
-	currDroplet := widget.currentDroplet()
-	if currDroplet == nil {
+	droplets := widget.selectedDroplets()
+	if len(droplets) == 0 {
+		return
+	}
+
+	if len(droplets) == 1 && !widget.settings.confirmDestroy {
+		widget.destroyDroplet(droplets[0])
 		return
 	}
 
-	widget.client.Droplets.Delete(context.Background(), currDroplet.ID)
+	widget.showBulkConfirmation("Destroy", droplets, func() {
+		widget.destroyDroplets(droplets)
+	})
+}
+
+// destroyDroplet deletes the given droplet via the DigitalOcean API and refreshes the widget
+func (widget *Widget) destroyDroplet(droplet *godo.Droplet) {
+	client := widget.clientFor(widget.accountForDroplet(droplet))
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	client.Droplets().Delete(ctx, droplet.ID)
 
 	widget.dropletRemoveSelected()
 	widget.Refresh()
 }
 
+// destroyDroplets deletes each of the given droplets via the DigitalOcean API, then clears
+// the selection set and refreshes the widget once, rather than once per droplet
+func (widget *Widget) destroyDroplets(droplets []*godo.Droplet) {
+	for _, droplet := range droplets {
+		client := widget.clientFor(widget.accountForDroplet(droplet))
+		if client == nil {
+			continue
+		}
+
+		ctx, cancel := widget.context()
+		client.Droplets().Delete(ctx, droplet.ID)
+		cancel()
+	}
+
+	widget.clearSelection()
+	widget.Refresh()
+}
+
 // dropletEnabledPrivateNetworking enabled private networking on the selected droplet
 func (widget *Widget) dropletEnabledPrivateNetworking() {
 	currDroplet := widget.currentDroplet()
@@ -192,43 +676,368 @@ func (widget *Widget) dropletEnabledPrivateNetworking() {
 		return
 	}
 
-	widget.client.DropletActions.EnablePrivateNetworking(context.Background(), currDroplet.ID)
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	client := widget.clientFor(widget.currentAccount())
+	if client == nil {
+		return
+	}
+
+	client.DropletActions().EnablePrivateNetworking(ctx, currDroplet.ID)
 	widget.Refresh()
 }
 
-// dropletRemoveSelected removes the currently-selected droplet from the internal list of droplets
-func (widget *Widget) dropletRemoveSelected() {
+// dropletPowerOn powers on the selected droplet
+func (widget *Widget) dropletPowerOn() {
 	currDroplet := widget.currentDroplet()
-	if currDroplet != nil {
-		widget.droplets[len(widget.droplets)-1], widget.droplets[widget.Selected] = widget.droplets[widget.Selected], widget.droplets[len(widget.droplets)-1]
-		widget.droplets = widget.droplets[:len(widget.droplets)-1]
+	if currDroplet == nil {
+		return
 	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	client := widget.clientFor(widget.currentAccount())
+	if client == nil {
+		return
+	}
+
+	client.DropletActions().PowerOn(ctx, currDroplet.ID)
+	widget.Refresh()
 }
 
-// dropletRestart restarts the selected droplet
-func (widget *Widget) dropletRestart() {
+// dropletRemoveSelected removes the currently-selected droplet from the internal list of
+// droplets, preserving the order of everything else, and clamps Selected back into range
+// if the removed droplet was last in the list
+func (widget *Widget) dropletRemoveSelected() {
+	if widget.currentDroplet() == nil {
+		return
+	}
+
+	idx := widget.Selected
+
+	widget.droplets = append(widget.droplets[:idx], widget.droplets[idx+1:]...)
+
+	if idx < len(widget.accounts) {
+		widget.accounts = append(widget.accounts[:idx], widget.accounts[idx+1:]...)
+	}
+
+	if widget.Selected >= len(widget.droplets) {
+		widget.Selected = len(widget.droplets) - 1
+	}
+}
+
+// dropletResize opens a modal listing the available droplet sizes and resizes the selected
+// droplet to whichever size is chosen. Resizing requires the droplet to be powered off, so the
+// modal warns and offers to power it down first if it's still active
+func (widget *Widget) dropletResize() {
 	currDroplet := widget.currentDroplet()
 	if currDroplet == nil {
 		return
 	}
 
-	widget.client.DropletActions.Reboot(context.Background(), currDroplet.ID)
-	widget.Refresh()
+	client := widget.clientFor(widget.currentAccount())
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	sizes, _, err := client.Sizes().List(ctx, &godo.ListOptions{})
+	if err != nil {
+		widget.err = err
+		widget.display()
+		return
+	}
+
+	widget.showResizeModal(client, currDroplet, sizes)
 }
 
-// dropletShutDown powers down the selected droplet
-func (widget *Widget) dropletShutDown() {
+// showResizeModal shows a modal listing the given sizes and resizes droplet to whichever is chosen
+func (widget *Widget) showResizeModal(client doClient, droplet *godo.Droplet, sizes []godo.Size) {
+	closeFunc := func() {
+		widget.pages.RemovePage("resize")
+		widget.app.SetFocus(widget.View)
+	}
+
+	list := tview.NewList()
+	list.ShowSecondaryText(false)
+
+	if droplet.Status == "active" {
+		list.AddItem("Power off, then resize", "", 0, nil)
+	}
+
+	for _, size := range sizes {
+		sizeSlug := size.Slug
+		list.AddItem(sizeSlug, "", 0, func() {
+			widget.dropletResizeTo(client, droplet, sizeSlug)
+			closeFunc()
+		})
+	}
+
+	list.SetDoneFunc(closeFunc)
+
+	frame := tview.NewFrame(list)
+	frame.SetTitle(fmt.Sprintf("  Resize %s  ", droplet.Name))
+	frame.SetBorder(true)
+
+	widget.pages.AddPage("resize", frame, true, true)
+	widget.app.SetFocus(list)
+}
+
+// dropletResizeTo resizes the given droplet to the given size slug, powering it off first
+// if necessary
+func (widget *Widget) dropletResizeTo(client doClient, droplet *godo.Droplet, sizeSlug string) {
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	if droplet.Status == "active" {
+		client.DropletActions().Shutdown(ctx, droplet.ID)
+	}
+
+	action, _, _ := client.DropletActions().Resize(ctx, droplet.ID, sizeSlug, false)
+	widget.watchAction(client, droplet.ID, action)
+}
+
+// dropletSnapshot prompts for a snapshot name, then requests a snapshot of the selected droplet.
+// Snapshotting is async, so this just shows a transient confirmation that the request went out
+func (widget *Widget) dropletSnapshot() {
 	currDroplet := widget.currentDroplet()
 	if currDroplet == nil {
 		return
 	}
 
-	widget.client.DropletActions.Shutdown(context.Background(), currDroplet.ID)
-	widget.Refresh()
+	client := widget.clientFor(widget.currentAccount())
+	if client == nil {
+		return
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage("snapshot")
+		widget.app.SetFocus(widget.View)
+	}
+
+	form := tview.NewForm()
+	form.AddInputField("Snapshot name", fmt.Sprintf("%s-snapshot", currDroplet.Name), 40, nil, nil)
+	form.AddButton("Create", func() {
+		name := form.GetFormItem(0).(*tview.InputField).GetText()
+		closeFunc()
+		widget.dropletSnapshotCreate(client, currDroplet, name)
+	})
+	form.AddButton("Cancel", closeFunc)
+	form.SetBorder(true)
+	form.SetTitle(fmt.Sprintf("  Snapshot %s  ", currDroplet.Name))
+
+	widget.pages.AddPage("snapshot", form, true, true)
+	widget.app.SetFocus(form)
+}
+
+// dropletSnapshotCreate requests a snapshot of the given droplet and shows a transient
+// confirmation message, since the snapshot itself completes asynchronously
+func (widget *Widget) dropletSnapshotCreate(client doClient, droplet *godo.Droplet, name string) {
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	client.DropletActions().Snapshot(ctx, droplet.ID, name)
+
+	widget.showTransientMessage(fmt.Sprintf("Snapshot %q requested", name))
+}
+
+// dropletSnapshotCount returns the number of existing snapshots for the given droplet, or
+// -1 if the count couldn't be determined
+func (widget *Widget) dropletSnapshotCount(droplet *godo.Droplet) int {
+	client := widget.clientFor(widget.currentAccount())
+	if client == nil {
+		return -1
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	snapshots, _, err := client.Droplets().Snapshots(ctx, droplet.ID, &godo.ListOptions{})
+	if err != nil {
+		return -1
+	}
+
+	return len(snapshots)
+}
+
+// dropletVolumes returns the block-storage volumes attached to the given droplet, fetching
+// them from the API at most once per refresh cycle and caching the result by droplet ID
+func (widget *Widget) dropletVolumes(droplet *godo.Droplet) []godo.Volume {
+	if volumes, ok := widget.volumeCache[droplet.ID]; ok {
+		return volumes
+	}
+
+	client := widget.clientFor(widget.currentAccount())
+	if client == nil {
+		return nil
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	volumes, _, err := client.Storage().ListVolumes(ctx, &godo.ListVolumeParams{Region: droplet.Region.Slug})
+	if err != nil {
+		return nil
+	}
+
+	attached := make([]godo.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		for _, id := range volume.DropletIDs {
+			if id == droplet.ID {
+				attached = append(attached, volume)
+				break
+			}
+		}
+	}
+
+	widget.volumeCache[droplet.ID] = attached
+
+	return attached
+}
+
+// dropletRestart restarts the selected droplet(s), showing a single confirmation listing
+// them first if more than one is selected
+func (widget *Widget) dropletRestart() {
+	droplets := widget.selectedDroplets()
+	if len(droplets) == 0 {
+		return
+	}
+
+	if len(droplets) == 1 {
+		widget.restartDroplet(droplets[0])
+		return
+	}
+
+	widget.showBulkConfirmation("Reboot", droplets, func() {
+		for _, droplet := range droplets {
+			widget.restartDroplet(droplet)
+		}
+		widget.clearSelection()
+	})
+}
+
+// restartDroplet reboots a single droplet and begins watching the resulting action
+func (widget *Widget) restartDroplet(droplet *godo.Droplet) {
+	client := widget.clientFor(widget.accountForDroplet(droplet))
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	action, _, _ := client.DropletActions().Reboot(ctx, droplet.ID)
+	widget.watchAction(client, droplet.ID, action)
+}
+
+// dropletShutDown powers down the selected droplet(s), showing a single confirmation
+// listing them first if more than one is selected
+func (widget *Widget) dropletShutDown() {
+	droplets := widget.selectedDroplets()
+	if len(droplets) == 0 {
+		return
+	}
+
+	if len(droplets) == 1 {
+		widget.shutDownDroplet(droplets[0])
+		return
+	}
+
+	widget.showBulkConfirmation("Shut down", droplets, func() {
+		for _, droplet := range droplets {
+			widget.shutDownDroplet(droplet)
+		}
+		widget.clearSelection()
+	})
+}
+
+// shutDownDroplet powers down a single droplet and begins watching the resulting action
+func (widget *Widget) shutDownDroplet(droplet *godo.Droplet) {
+	client := widget.clientFor(widget.accountForDroplet(droplet))
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := widget.context()
+	defer cancel()
+
+	action, _, _ := client.DropletActions().Shutdown(ctx, droplet.ID)
+	widget.watchAction(client, droplet.ID, action)
 }
 
 /* -------------------- Common Actions -------------------- */
 
+// showBulkConfirmation shows a modal window listing the given droplets by name and asking
+// the user to confirm actionLabel (e.g. "Destroy") against all of them at once, calling
+// onConfirm if they do
+func (widget *Widget) showBulkConfirmation(actionLabel string, droplets []*godo.Droplet, onConfirm func()) {
+	closeFunc := func() {
+		widget.pages.RemovePage("bulkConfirmation")
+		widget.app.SetFocus(widget.View)
+	}
+
+	names := make([]string, 0, len(droplets))
+	for _, droplet := range droplets {
+		names = append(names, droplet.Name)
+	}
+
+	noun := "droplet"
+	if len(droplets) != 1 {
+		noun = "droplets"
+	}
+
+	modal := tview.NewModal()
+	modal.SetText(fmt.Sprintf("%s %d %s?\n\n%s", actionLabel, len(droplets), noun, strings.Join(names, "\n")))
+	modal.AddButtons([]string{"Yes", "No"})
+	modal.SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+		if buttonLabel == "Yes" {
+			onConfirm()
+		}
+		closeFunc()
+	})
+
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeFunc()
+			return nil
+		}
+
+		if string(event.Rune()) == "n" || string(event.Rune()) == "N" {
+			closeFunc()
+			return nil
+		}
+
+		return event
+	})
+
+	widget.pages.AddPage("bulkConfirmation", modal, false, true)
+	widget.app.SetFocus(modal)
+
+	widget.app.QueueUpdateDraw(func() {
+		widget.app.Draw()
+	})
+}
+
+// showTransientMessage briefly shows a one-line status message, then dismisses itself
+func (widget *Widget) showTransientMessage(message string) {
+	modal := tview.NewModal()
+	modal.SetText(message)
+
+	widget.pages.AddPage("transientMessage", modal, true, true)
+
+	time.AfterFunc(2*time.Second, func() {
+		widget.app.QueueUpdateDraw(func() {
+			widget.pages.RemovePage("transientMessage")
+			widget.app.SetFocus(widget.View)
+		})
+	})
+}
+
 // showInfo shows a modal window with information about the selected droplet
 func (widget *Widget) showInfo() {
 	droplet := widget.currentDroplet()
@@ -241,8 +1050,12 @@ func (widget *Widget) showInfo() {
 		widget.app.SetFocus(widget.View)
 	}
 
-	propTable := newDropletPropertiesTable(droplet).render()
-	propTable += utils.CenterText("Esc to close", 80)
+	cpuUsage, memoryUsage := "", ""
+	if widget.settings.showMetrics {
+		cpuUsage, memoryUsage = widget.dropletMetrics(droplet)
+	}
+
+	propTable := newDropletPropertiesTable(droplet, widget.dropletSnapshotCount(droplet), widget.dropletVolumes(droplet), widget.settings.showMetrics, cpuUsage, memoryUsage, widget.settings.relativeTime).render()
 
 	modal := view.NewBillboardModal(propTable, closeFunc)
 	modal.SetTitle(fmt.Sprintf("  %s  ", droplet.Name))
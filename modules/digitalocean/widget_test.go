@@ -0,0 +1,176 @@
+package digitalocean
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/wtfutil/wtf/view"
+)
+
+func Test_accountDropletsFetch_pagination(t *testing.T) {
+	page1 := []godo.Droplet{{ID: 1, Name: "one"}}
+	page2 := []godo.Droplet{{ID: 2, Name: "two"}}
+
+	client := fakeClient{
+		droplets: &fakeDropletsService{
+			listFunc: func(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+				resp := &godo.Response{Response: &http.Response{}}
+				resp.Rate.Remaining = 100
+
+				if opts.Page <= 1 {
+					resp.Links = &godo.Links{Pages: &godo.Pages{Next: "?page=2", Last: "?page=2"}}
+					return page1, resp, nil
+				}
+
+				resp.Links = &godo.Links{Pages: &godo.Pages{Prev: "?page=1"}}
+				return page2, resp, nil
+			},
+		},
+	}
+
+	widget := &Widget{settings: &Settings{timeout: 5}, ctx: context.Background()}
+
+	droplets, rate, err := widget.accountDropletsFetch(client, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rate.Remaining != 100 {
+		t.Errorf("expected the last page's rate limit to be returned, got remaining=%d", rate.Remaining)
+	}
+
+	if len(droplets) != 2 {
+		t.Fatalf("expected 2 droplets across both pages, got %d", len(droplets))
+	}
+	if droplets[0].ID != 1 || droplets[1].ID != 2 {
+		t.Errorf("expected droplets in page order, got %+v", droplets)
+	}
+}
+
+func Test_accountDropletsFetch_maxItems(t *testing.T) {
+	page1 := []godo.Droplet{{ID: 1, Name: "one"}}
+	page2 := []godo.Droplet{{ID: 2, Name: "two"}}
+
+	client := fakeClient{
+		droplets: &fakeDropletsService{
+			listFunc: func(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, *godo.Response, error) {
+				resp := &godo.Response{Response: &http.Response{}}
+
+				if opts.Page <= 1 {
+					resp.Links = &godo.Links{Pages: &godo.Pages{Next: "?page=2", Last: "?page=2"}}
+					return page1, resp, nil
+				}
+
+				t.Fatal("expected fetching to stop once maxItems was reached, but a second page was requested")
+				return page2, resp, nil
+			},
+		},
+	}
+
+	widget := &Widget{settings: &Settings{timeout: 5}, ctx: context.Background()}
+
+	droplets, _, err := widget.accountDropletsFetch(client, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(droplets) != 1 {
+		t.Fatalf("expected fetching to stop at 1 droplet, got %d", len(droplets))
+	}
+}
+
+func Test_currentDroplet(t *testing.T) {
+	droplets := []godo.Droplet{{ID: 1}, {ID: 2}}
+
+	tests := []struct {
+		name     string
+		selected int
+		expectID int
+	}{
+		{"in bounds", 1, 2},
+		{"negative selection", -1, 0},
+		{"selection past the end", 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			widget := &Widget{
+				ScrollableWidget: view.ScrollableWidget{Selected: tt.selected},
+				droplets:         droplets,
+			}
+
+			droplet := widget.currentDroplet()
+
+			if tt.expectID == 0 {
+				if droplet != nil {
+					t.Errorf("expected nil droplet, got %+v", droplet)
+				}
+				return
+			}
+
+			if droplet == nil || droplet.ID != tt.expectID {
+				t.Errorf("expected droplet %d, got %+v", tt.expectID, droplet)
+			}
+		})
+	}
+}
+
+func Test_dropletMatchesFilter(t *testing.T) {
+	widget := &Widget{droplets: []godo.Droplet{{Name: "web-1"}, {Name: "db-1"}}}
+
+	if !widget.dropletMatchesFilter(0, "WEB") {
+		t.Errorf("expected a case-insensitive substring match against the droplet name")
+	}
+
+	if widget.dropletMatchesFilter(1, "web") {
+		t.Errorf("expected no match for a droplet whose name doesn't contain the filter text")
+	}
+
+	if widget.dropletMatchesFilter(5, "web") {
+		t.Errorf("expected no match for an out-of-range index")
+	}
+}
+
+func Test_dropletRemoveSelected(t *testing.T) {
+	t.Run("removing a middle element preserves order", func(t *testing.T) {
+		widget := &Widget{
+			ScrollableWidget: view.ScrollableWidget{Selected: 1},
+			droplets:         []godo.Droplet{{ID: 1}, {ID: 2}, {ID: 3}},
+			accounts:         []string{"a", "b", "c"},
+		}
+
+		widget.dropletRemoveSelected()
+
+		if len(widget.droplets) != 2 {
+			t.Fatalf("expected 2 droplets remaining, got %d", len(widget.droplets))
+		}
+		if widget.droplets[0].ID != 1 || widget.droplets[1].ID != 3 {
+			t.Errorf("expected order [1 3], got %+v", widget.droplets)
+		}
+		if widget.accounts[0] != "a" || widget.accounts[1] != "c" {
+			t.Errorf("expected accounts to stay parallel to droplets, got %+v", widget.accounts)
+		}
+		if widget.Selected != 1 {
+			t.Errorf("expected selection to stay at index 1 (now droplet 3), got %d", widget.Selected)
+		}
+	})
+
+	t.Run("removing the last element clamps the selection", func(t *testing.T) {
+		widget := &Widget{
+			ScrollableWidget: view.ScrollableWidget{Selected: 2},
+			droplets:         []godo.Droplet{{ID: 1}, {ID: 2}, {ID: 3}},
+			accounts:         []string{"a", "b", "c"},
+		}
+
+		widget.dropletRemoveSelected()
+
+		if len(widget.droplets) != 2 {
+			t.Fatalf("expected 2 droplets remaining, got %d", len(widget.droplets))
+		}
+		if widget.Selected != 1 {
+			t.Errorf("expected selection clamped to 1, got %d", widget.Selected)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package docker
+
+// containerPowerOn starts the selected container
+func (widget *Widget) containerPowerOn() {
+	currContainer := widget.currentContainer()
+	if currContainer == nil {
+		return
+	}
+
+	widget.containerStart(currContainer.ID)
+	widget.Refresh()
+}
+
+// containerPowerOff stops the selected container
+func (widget *Widget) containerPowerOff() {
+	currContainer := widget.currentContainer()
+	if currContainer == nil {
+		return
+	}
+
+	widget.containerStop(currContainer.ID)
+	widget.Refresh()
+}
+
+// containerReboot restarts the selected container
+func (widget *Widget) containerReboot() {
+	currContainer := widget.currentContainer()
+	if currContainer == nil {
+		return
+	}
+
+	widget.containerRestart(currContainer.ID)
+	widget.Refresh()
+}
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 )
 
+// getSystemInfo renders the Docker daemon's name, version, and disk usage
 func (widget *Widget) getSystemInfo() string {
 	info, err := widget.cli.Info(context.Background())
 	if err != nil {
@@ -45,9 +48,6 @@ func (widget *Widget) getSystemInfo() string {
 		}, {
 			name:  "version:",
 			value: fmt.Sprintf("[%s]%s", widget.settings.common.Colors.RowTheme.EvenForeground, info.ServerVersion),
-		}, {
-			name:  "root:",
-			value: fmt.Sprintf("[%s]%s", widget.settings.common.Colors.RowTheme.EvenForeground, info.DockerRootDir),
 		},
 		{
 			name: "containers:",
@@ -59,14 +59,6 @@ func (widget *Widget) getSystemInfo() string {
 			name:  "images:",
 			value: fmt.Sprintf("[%s]%d", widget.settings.common.Colors.RowTheme.EvenForeground, info.Images),
 		},
-		{
-			name:  "volumes:",
-			value: fmt.Sprintf("[%s]%v", widget.settings.common.Colors.RowTheme.EvenForeground, len(diskUsage.Volumes)),
-		},
-		{
-			name:  "memory limit:",
-			value: fmt.Sprintf("[%s]%s", widget.settings.common.Colors.RowTheme.EvenForeground, humanize.Bytes(uint64(info.MemTotal))),
-		},
 		{
 			name: "disk usage:",
 			value: fmt.Sprintf(`
@@ -107,57 +99,57 @@ func (widget *Widget) getSystemInfo() string {
 	return result
 }
 
-func (widget *Widget) getContainerStates() string {
-	cntrs, err := widget.cli.ContainerList(context.Background(), types.ContainerListOptions{All: true})
-	if err != nil {
-		return errors.Wrapf(err, "could not get container list").Error()
-	}
-
-	if len(cntrs) == 0 {
-		return "no containers"
+// newClient creates a Docker API client connected to host, falling back to the standard
+// Docker environment variables (DOCKER_HOST and friends) when host is empty
+func newClient(host string) (*client.Client, error) {
+	if host == "" {
+		return client.NewEnvClient()
 	}
 
-	colorMap := map[string]string{
-		"created":    "green",
-		"running":    "lime",
-		"paused":     "yellow",
-		"restarting": "yellow",
-		"removing":   "yellow",
-		"exited":     "red",
-		"dead":       "red",
-	}
+	return client.NewClient(host, "", nil, nil)
+}
 
-	containers := []struct {
-		name  string
-		state string
-	}{}
-	for _, c := range cntrs {
-		container := struct {
-			name  string
-			state string
-		}{
-			name:  c.Names[0],
-			state: c.State,
-		}
-
-		container.name = strings.Replace(container.name, "/", "", -1)
-		containers = append(containers, container)
+// containerList fetches the current containers, including stopped ones if showStopped is
+// enabled, sorted by name
+func (widget *Widget) containerList() ([]types.Container, error) {
+	containers, err := widget.cli.ContainerList(context.Background(), types.ContainerListOptions{All: widget.settings.showStopped})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get container list")
 	}
 
 	sort.Slice(containers, func(i, j int) bool {
-		return containers[i].name < containers[j].name
+		return containerName(containers[i]) < containerName(containers[j])
 	})
 
-	padSlice(false, containers, func(i int) string {
-		return containers[i].name
-	}, func(i int, val string) {
-		containers[i].name = val
-	})
+	return containers, nil
+}
 
-	result := ""
-	for _, c := range containers {
-		result += fmt.Sprintf("[white]%s [%s]%s\n", c.name, colorMap[c.state], c.state)
+// containerName returns a container's display name: its first name, with the leading
+// slash the Docker API always prefixes names with stripped off
+func containerName(container types.Container) string {
+	if len(container.Names) == 0 {
+		return container.ID
 	}
 
-	return result
+	return strings.TrimPrefix(container.Names[0], "/")
+}
+
+// containerStart starts the given container
+func (widget *Widget) containerStart(containerID string) error {
+	return widget.cli.ContainerStart(context.Background(), containerID, types.ContainerStartOptions{})
+}
+
+// containerStop stops the given container, giving it up to stopTimeout to shut down cleanly
+const stopTimeout = 10 * time.Second
+
+func (widget *Widget) containerStop(containerID string) error {
+	timeout := stopTimeout
+	return widget.cli.ContainerStop(context.Background(), containerID, &timeout)
+}
+
+// containerRestart restarts the given container, giving it up to stopTimeout to shut down
+// cleanly before it's forced
+func (widget *Widget) containerRestart(containerID string) error {
+	timeout := stopTimeout
+	return widget.cli.ContainerRestart(context.Background(), containerID, &timeout)
 }
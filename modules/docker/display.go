@@ -0,0 +1,77 @@
+package docker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/wtfutil/wtf/utils"
+)
+
+// containerStateColor returns the display color for a container's state
+func containerStateColor(state string) string {
+	switch state {
+	case "running":
+		return "lime"
+	case "created", "restarting":
+		return "yellow"
+	case "paused":
+		return "yellow"
+	case "exited", "dead":
+		return "red"
+	default:
+		return "white"
+	}
+}
+
+// containerPorts renders a container's published ports as a compact comma-separated list
+func containerPorts(ports []types.Port) string {
+	rendered := []string{}
+
+	for _, port := range ports {
+		if port.PublicPort == 0 {
+			continue
+		}
+
+		rendered = append(rendered, fmt.Sprintf("%d:%d", port.PublicPort, port.PrivatePort))
+	}
+
+	return strings.Join(rendered, ",")
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		title = fmt.Sprintf("%s [red]![white]", title)
+		return title, widget.err.Error(), true
+	}
+
+	str := "[" + widget.settings.labelColor + "::bul]system\n"
+	str += widget.getSystemInfo()
+
+	str += "\n[" + widget.settings.labelColor + "::bul]containers\n\n"
+
+	for idx, container := range widget.containers {
+		rowColor := widget.RowColor(idx)
+
+		row := fmt.Sprintf(
+			"[%s]%-24s %-24s [%s]%-10s[%s] %s",
+			rowColor,
+			utils.Truncate(containerName(container), 24, true),
+			utils.Truncate(container.Image, 24, true),
+			containerStateColor(container.State),
+			container.State,
+			rowColor,
+			containerPorts(container.Ports),
+		)
+
+		str += utils.HighlightableHelper(widget.View, row, idx, 26)
+	}
+
+	return title, str, true
+}
+
+func (widget *Widget) display() {
+	widget.ScrollableWidget.Redraw(widget.content)
+}
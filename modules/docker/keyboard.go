@@ -0,0 +1,19 @@
+package docker
+
+import "github.com/gdamore/tcell"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeCommonControls(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Prev, "Select previous item")
+	widget.SetKeyboardChar("k", widget.Next, "Select next item")
+	widget.SetKeyboardChar("o", widget.containerPowerOn, "Start the selected container")
+	widget.SetKeyboardChar("s", widget.containerPowerOff, "Stop the selected container")
+	widget.SetKeyboardChar("b", widget.containerReboot, "Restart the selected container")
+	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
+	widget.SetKeyboardKey(tcell.KeyPgDn, widget.NextPage, "Select the item a page down")
+	widget.SetKeyboardKey(tcell.KeyPgUp, widget.PrevPage, "Select the item a page up")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
+}
@@ -6,7 +6,7 @@ import (
 )
 
 const (
-	defaultFocusable = false
+	defaultFocusable = true
 	defaultTitle     = "docker"
 )
 
@@ -14,6 +14,9 @@ const (
 type Settings struct {
 	common     *cfg.Common
 	labelColor string
+
+	host        string `help:"The Docker daemon socket to connect to, e.g. tcp://1.2.3.4:2376." optional:"true"`
+	showStopped bool   `help:"Whether or not to include stopped/exited containers in the list." values:"true or false" optional:"true"`
 }
 
 // NewSettingsFromYAML creates and returns an instance of Settings with configuration options populated
@@ -21,6 +24,9 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	settings := Settings{
 		common:     cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 		labelColor: ymlConfig.UString("labelColor", "white"),
+
+		host:        ymlConfig.UString("host", ""),
+		showStopped: ymlConfig.UBool("showStopped", true),
 	}
 
 	return &settings
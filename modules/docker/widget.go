@@ -1,64 +1,120 @@
 package docker
 
 import (
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/client"
 	"github.com/pkg/errors"
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/view"
 )
 
+// Widget is the container for Docker data
 type Widget struct {
-	view.TextWidget
-	cli           *client.Client
-	settings      *Settings
-	displayBuffer string
+	view.KeyboardWidget
+	view.ScrollableWidget
+
+	cli        *client.Client
+	settings   *Settings
+	containers []types.Container
+	err        error
 }
 
+// NewWidget creates a new instance of a widget
 func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
 	widget := Widget{
-		TextWidget: view.NewTextWidget(app, settings.common),
-		settings:   settings,
-	}
+		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
+		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
 
-	widget.View.SetScrollable(true)
+		settings: settings,
+	}
 
-	cli, err := client.NewEnvClient()
+	cli, err := newClient(settings.host)
 	if err != nil {
-		widget.displayBuffer = errors.Wrap(err, "could not create client").Error()
+		widget.err = errors.Wrap(err, "could not create client")
 	} else {
 		widget.cli = cli
 	}
 
-	widget.refreshDisplayBuffer()
+	widget.initializeKeyboardControls()
+	widget.View.SetInputCapture(widget.InputCapture)
+
+	widget.View.SetScrollable(true)
+
+	widget.KeyboardWidget.SetView(widget.View)
+	widget.SetRenderFunction(widget.display)
 
 	return &widget
 }
 
 /* -------------------- Exported Functions -------------------- */
 
-func (widget *Widget) Refresh() {
-	widget.refreshDisplayBuffer()
-	widget.Redraw(widget.display)
+// HelpText returns the help text for this widget
+func (widget *Widget) HelpText() string {
+	return widget.KeyboardWidget.HelpText()
 }
 
-/* -------------------- Unexported Functions -------------------- */
+// Next selects the next item in the list
+func (widget *Widget) Next() {
+	widget.ScrollableWidget.Next()
+}
+
+// Prev selects the previous item in the list
+func (widget *Widget) Prev() {
+	widget.ScrollableWidget.Prev()
+}
+
+// NextPage selects the item a page down from the current selection
+func (widget *Widget) NextPage() {
+	widget.ScrollableWidget.NextPage()
+}
 
-func (widget *Widget) display() (string, string, bool) {
-	return widget.CommonSettings().Title, widget.displayBuffer, true
+// PrevPage selects the item a page up from the current selection
+func (widget *Widget) PrevPage() {
+	widget.ScrollableWidget.PrevPage()
 }
 
-func (widget *Widget) refreshDisplayBuffer() {
+// Refresh updates the data for this widget and displays it onscreen
+func (widget *Widget) Refresh() {
+	widget.display()
+
 	if widget.cli == nil {
+		widget.SetItemCount(0)
+		widget.display()
 		return
 	}
 
-	widget.displayBuffer = ""
+	containers, err := widget.containerList()
+	if err != nil {
+		widget.err = err
+		widget.SetItemCount(0)
+	} else {
+		widget.err = nil
+		widget.containers = containers
+		widget.SetItemCount(len(widget.containers))
+	}
 
-	widget.displayBuffer += "[" + widget.settings.labelColor + "::bul]system\n"
-	widget.displayBuffer += widget.getSystemInfo()
+	widget.display()
+}
 
-	widget.displayBuffer += "\n"
+// RefreshError returns the error from the widget's most recent refresh, or nil if it
+// succeeded
+func (widget *Widget) RefreshError() error {
+	return widget.err
+}
+
+// Unselect clears the selection of list items
+func (widget *Widget) Unselect() {
+	widget.ScrollableWidget.Unselect()
+	widget.RenderFunction()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// currentContainer returns the currently-selected container, or nil if there isn't one
+func (widget *Widget) currentContainer() *types.Container {
+	if widget.Selected < 0 || widget.Selected >= len(widget.containers) {
+		return nil
+	}
 
-	widget.displayBuffer += "[" + widget.settings.labelColor + "::bul]containers\n"
-	widget.displayBuffer += widget.getContainerStates()
+	return &widget.containers[widget.Selected]
 }
@@ -0,0 +1,102 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/gdamore/tcell"
+	"github.com/rivo/tview"
+)
+
+// instancePowerOn starts the selected instance
+func (widget *Widget) instancePowerOn() {
+	currInstance := widget.currentInstance()
+	if currInstance == nil {
+		return
+	}
+
+	widget.instanceStart(aws.StringValue(currInstance.InstanceId))
+	widget.Refresh()
+}
+
+// instancePowerOff stops the selected instance, showing a confirmation modal first if the
+// confirmActions setting is enabled
+func (widget *Widget) instancePowerOff() {
+	currInstance := widget.currentInstance()
+	if currInstance == nil {
+		return
+	}
+
+	if !widget.settings.confirmActions {
+		widget.instanceStop(aws.StringValue(currInstance.InstanceId))
+		widget.Refresh()
+		return
+	}
+
+	widget.showConfirmation("Stop", currInstance, func() {
+		widget.instanceStop(aws.StringValue(currInstance.InstanceId))
+		widget.Refresh()
+	})
+}
+
+// instanceReboot reboots the selected instance, showing a confirmation modal first if the
+// confirmActions setting is enabled
+func (widget *Widget) instanceReboot() {
+	currInstance := widget.currentInstance()
+	if currInstance == nil {
+		return
+	}
+
+	if !widget.settings.confirmActions {
+		widget.instanceRestart(aws.StringValue(currInstance.InstanceId))
+		widget.Refresh()
+		return
+	}
+
+	widget.showConfirmation("Reboot", currInstance, func() {
+		widget.instanceRestart(aws.StringValue(currInstance.InstanceId))
+		widget.Refresh()
+	})
+}
+
+// showConfirmation shows a modal window asking the user to confirm actionLabel (e.g. "Stop")
+// against the given instance, calling onConfirm if they do
+func (widget *Widget) showConfirmation(actionLabel string, instance *ec2.Instance, onConfirm func()) {
+	closeFunc := func() {
+		widget.pages.RemovePage("ec2Confirmation")
+		widget.app.SetFocus(widget.View)
+	}
+
+	modal := tview.NewModal()
+	modal.SetText(fmt.Sprintf("%s instance %q?", actionLabel, instanceName(instance)))
+	modal.AddButtons([]string{"Yes", "No"})
+	modal.SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+		if buttonLabel == "Yes" {
+			onConfirm()
+		}
+		closeFunc()
+	})
+
+	modal.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			closeFunc()
+			return nil
+		}
+
+		if string(event.Rune()) == "n" || string(event.Rune()) == "N" {
+			closeFunc()
+			return nil
+		}
+
+		return event
+	})
+
+	widget.pages.AddPage("ec2Confirmation", modal, false, true)
+	widget.app.SetFocus(modal)
+
+	widget.app.QueueUpdateDraw(func() {
+		widget.app.Draw()
+	})
+}
@@ -0,0 +1,82 @@
+package ec2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// newClient creates an EC2 API client for the configured region, using the given named
+// profile if one is set, or the standard AWS credential chain otherwise
+func newClient(profile, region string) (*ec2.EC2, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Profile:           profile,
+		SharedConfigState: session.SharedConfigEnable,
+		Config:            aws.Config{Region: aws.String(region)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ec2.New(sess), nil
+}
+
+// instanceList fetches every instance visible to the configured profile/region, across all
+// reservations, sorted by name
+func (widget *Widget) instanceList() ([]*ec2.Instance, error) {
+	out, err := widget.cli.DescribeInstances(&ec2.DescribeInstancesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	instances := []*ec2.Instance{}
+	for _, reservation := range out.Reservations {
+		instances = append(instances, reservation.Instances...)
+	}
+
+	sort.Slice(instances, func(i, j int) bool {
+		return instanceName(instances[i]) < instanceName(instances[j])
+	})
+
+	return instances, nil
+}
+
+// instanceName returns an instance's "Name" tag, or its instance ID if it has none
+func instanceName(instance *ec2.Instance) string {
+	for _, tag := range instance.Tags {
+		if aws.StringValue(tag.Key) == "Name" {
+			return aws.StringValue(tag.Value)
+		}
+	}
+
+	return aws.StringValue(instance.InstanceId)
+}
+
+// instanceStart starts the given instance
+func (widget *Widget) instanceStart(instanceID string) error {
+	_, err := widget.cli.StartInstances(&ec2.StartInstancesInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+
+	return err
+}
+
+// instanceStop stops the given instance
+func (widget *Widget) instanceStop(instanceID string) error {
+	_, err := widget.cli.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+
+	return err
+}
+
+// instanceRestart reboots the given instance
+func (widget *Widget) instanceRestart(instanceID string) error {
+	_, err := widget.cli.RebootInstances(&ec2.RebootInstancesInput{
+		InstanceIds: []*string{aws.String(instanceID)},
+	})
+
+	return err
+}
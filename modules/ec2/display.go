@@ -0,0 +1,62 @@
+package ec2
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/wtfutil/wtf/utils"
+)
+
+// instanceStateColor returns the display color for an instance's state
+func instanceStateColor(state string) string {
+	switch state {
+	case "running":
+		return "lime"
+	case "pending", "rebooting", "stopping":
+		return "yellow"
+	case "stopped", "shutting-down", "terminated":
+		return "red"
+	default:
+		return "white"
+	}
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		title = fmt.Sprintf("%s [red]![white]", title)
+		return title, widget.err.Error(), true
+	}
+
+	str := ""
+
+	for idx, instance := range widget.instances {
+		rowColor := widget.RowColor(idx)
+
+		state := ""
+		if instance.State != nil {
+			state = aws.StringValue(instance.State.Name)
+		}
+
+		row := fmt.Sprintf(
+			"[%s]%-24s %-20s %-12s [%s]%-16s[%s] %s",
+			rowColor,
+			utils.Truncate(instanceName(instance), 24, true),
+			aws.StringValue(instance.InstanceId),
+			aws.StringValue(instance.InstanceType),
+			instanceStateColor(state),
+			state,
+			rowColor,
+			aws.StringValue(instance.PublicIpAddress),
+		)
+
+		str += utils.HighlightableHelper(widget.View, row, idx, 26)
+	}
+
+	return title, str, true
+}
+
+func (widget *Widget) display() {
+	widget.ScrollableWidget.Redraw(widget.content)
+}
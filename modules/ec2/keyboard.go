@@ -0,0 +1,19 @@
+package ec2
+
+import "github.com/gdamore/tcell"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeCommonControls(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Prev, "Select previous item")
+	widget.SetKeyboardChar("k", widget.Next, "Select next item")
+	widget.SetKeyboardChar("o", widget.instancePowerOn, "Start the selected instance")
+	widget.SetKeyboardChar("s", widget.instancePowerOff, "Stop the selected instance")
+	widget.SetKeyboardChar("b", widget.instanceReboot, "Reboot the selected instance")
+	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
+	widget.SetKeyboardKey(tcell.KeyPgDn, widget.NextPage, "Select the item a page down")
+	widget.SetKeyboardKey(tcell.KeyPgUp, widget.PrevPage, "Select the item a page up")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
+}
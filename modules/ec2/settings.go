@@ -0,0 +1,33 @@
+package ec2
+
+import (
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "EC2"
+)
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	common *cfg.Common
+
+	profile        string `help:"The named AWS credentials profile to use. Falls back to the standard AWS credential chain (environment variables, shared config, instance role) when unset." optional:"true"`
+	region         string `help:"The AWS region to list instances in." optional:"true"`
+	confirmActions bool   `help:"Whether or not to show a confirmation prompt before stopping or rebooting an instance." values:"true or false" optional:"true"`
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		profile:        ymlConfig.UString("profile", ""),
+		region:         ymlConfig.UString("region", "us-east-1"),
+		confirmActions: ymlConfig.UBool("confirmActions", true),
+	}
+
+	return &settings
+}
@@ -0,0 +1,123 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+// Widget is the container for EC2 data
+type Widget struct {
+	view.KeyboardWidget
+	view.ScrollableWidget
+
+	app       *tview.Application
+	pages     *tview.Pages
+	cli       *ec2.EC2
+	settings  *Settings
+	instances []*ec2.Instance
+	err       error
+}
+
+// NewWidget creates a new instance of a widget
+func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
+		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
+
+		app:      app,
+		pages:    pages,
+		settings: settings,
+	}
+
+	cli, err := newClient(settings.profile, settings.region)
+	if err != nil {
+		widget.err = errors.Wrap(err, "could not create client")
+	} else {
+		widget.cli = cli
+	}
+
+	widget.initializeKeyboardControls()
+	widget.View.SetInputCapture(widget.InputCapture)
+
+	widget.View.SetScrollable(true)
+
+	widget.KeyboardWidget.SetView(widget.View)
+	widget.SetRenderFunction(widget.display)
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// HelpText returns the help text for this widget
+func (widget *Widget) HelpText() string {
+	return widget.KeyboardWidget.HelpText()
+}
+
+// Next selects the next item in the list
+func (widget *Widget) Next() {
+	widget.ScrollableWidget.Next()
+}
+
+// Prev selects the previous item in the list
+func (widget *Widget) Prev() {
+	widget.ScrollableWidget.Prev()
+}
+
+// NextPage selects the item a page down from the current selection
+func (widget *Widget) NextPage() {
+	widget.ScrollableWidget.NextPage()
+}
+
+// PrevPage selects the item a page up from the current selection
+func (widget *Widget) PrevPage() {
+	widget.ScrollableWidget.PrevPage()
+}
+
+// Refresh updates the data for this widget and displays it onscreen
+func (widget *Widget) Refresh() {
+	widget.display()
+
+	if widget.cli == nil {
+		widget.SetItemCount(0)
+		widget.display()
+		return
+	}
+
+	instances, err := widget.instanceList()
+	if err != nil {
+		widget.err = err
+		widget.SetItemCount(0)
+	} else {
+		widget.err = nil
+		widget.instances = instances
+		widget.SetItemCount(len(widget.instances))
+	}
+
+	widget.display()
+}
+
+// RefreshError returns the error from the widget's most recent refresh, or nil if it
+// succeeded
+func (widget *Widget) RefreshError() error {
+	return widget.err
+}
+
+// Unselect clears the selection of list items
+func (widget *Widget) Unselect() {
+	widget.ScrollableWidget.Unselect()
+	widget.RenderFunction()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// currentInstance returns the currently-selected instance, or nil if there isn't one
+func (widget *Widget) currentInstance() *ec2.Instance {
+	if widget.Selected < 0 || widget.Selected >= len(widget.instances) {
+		return nil
+	}
+
+	return widget.instances[widget.Selected]
+}
@@ -0,0 +1,65 @@
+package feedreader
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/wtfutil/wtf/cfg"
+)
+
+// readCache is the on-disk representation of which story links have been marked read
+type readCache struct {
+	ReadLinks map[string]bool `json:"readLinks"`
+}
+
+// cacheFilePath returns the path to this widget's on-disk read-status cache file
+func (widget *Widget) cacheFilePath() (string, error) {
+	configDir, err := cfg.WtfConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "cache", "feedreader-"+widget.Name()+".json"), nil
+}
+
+// loadReadLinks populates the widget with whatever read-status data was cached on a
+// previous run, so previously-read stories still show as read after a restart
+func (widget *Widget) loadReadLinks() {
+	path, err := widget.cacheFilePath()
+	if err != nil {
+		return
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	var cached readCache
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return
+	}
+
+	widget.readLinks = cached.ReadLinks
+}
+
+// saveReadLinks writes the widget's current read-status data to disk
+func (widget *Widget) saveReadLinks() {
+	path, err := widget.cacheFilePath()
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return
+	}
+
+	data, err := json.Marshal(readCache{ReadLinks: widget.readLinks})
+	if err != nil {
+		return
+	}
+
+	_ = ioutil.WriteFile(path, data, 0600)
+}
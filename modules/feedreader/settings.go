@@ -15,8 +15,10 @@ const (
 type Settings struct {
 	common *cfg.Common
 
-	feeds     []string `help:"An array of RSS and Atom feed URLs"`
-	feedLimit int      `help:"The maximum number of stories to display for each feed"`
+	feeds         []string       `help:"An array of RSS and Atom feed URLs"`
+	feedLimit     int            `help:"The maximum number of stories to display for each feed"`
+	feedIntervals map[string]int `help:"A map of feed URL to how often, in seconds, that feed should be refetched, overriding the widget's refreshInterval on a per-feed basis." values:"A map of strings to positive integers." optional:"true"`
+	maxItems      int            `help:"The maximum number of stories to show across all feeds combined, after merging and sorting. 0 means no cap." optional:"true"`
 }
 
 // NewSettingsFromYAML creates a new settings instance from a YAML config block
@@ -24,9 +26,28 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	settings := &Settings{
 		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
-		feeds:     utils.ToStrs(ymlConfig.UList("feeds")),
-		feedLimit: ymlConfig.UInt("feedLimit", -1),
+		feeds:         utils.ToStrs(ymlConfig.UList("feeds")),
+		feedLimit:     ymlConfig.UInt("feedLimit", -1),
+		feedIntervals: intMap(ymlConfig, "feedIntervals"),
+		maxItems:      ymlConfig.UInt("maxItems", 0),
 	}
 
 	return settings
 }
+
+// intMap reads a setting that's a map of string to integer, discarding any entries whose
+// value isn't a number
+func intMap(ymlConfig *config.Config, key string) map[string]int {
+	result := map[string]int{}
+
+	for k, v := range ymlConfig.UMap(key) {
+		switch n := v.(type) {
+		case int:
+			result[k] = n
+		case float64:
+			result[k] = int(n)
+		}
+	}
+
+	return result
+}
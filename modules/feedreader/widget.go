@@ -18,6 +18,7 @@ const (
 // FeedItem represents an item returned from an RSS or Atom feed
 type FeedItem struct {
 	item   *gofeed.Item
+	source string
 	viewed bool
 }
 
@@ -26,10 +27,13 @@ type Widget struct {
 	view.KeyboardWidget
 	view.ScrollableWidget
 
-	stories  []*FeedItem
-	parser   *gofeed.Parser
-	settings *Settings
-	err      error
+	stories     []*FeedItem
+	feedItems   map[string][]*FeedItem
+	lastFetched map[string]time.Time
+	readLinks   map[string]bool
+	parser      *gofeed.Parser
+	settings    *Settings
+	err         error
 }
 
 // NewWidget creates a new instance of a widget
@@ -38,10 +42,15 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
 		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
 
-		parser:   gofeed.NewParser(),
-		settings: settings,
+		feedItems:   make(map[string][]*FeedItem),
+		lastFetched: make(map[string]time.Time),
+		readLinks:   make(map[string]bool),
+		parser:      gofeed.NewParser(),
+		settings:    settings,
 	}
 
+	widget.loadReadLinks()
+
 	widget.SetRenderFunction(widget.Render)
 	widget.initializeKeyboardControls()
 	widget.View.SetInputCapture(widget.InputCapture)
@@ -53,23 +62,36 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 /* -------------------- Exported Functions -------------------- */
 
-// Fetch retrieves RSS and Atom feed data
+// Fetch retrieves RSS and Atom feed data, merges it across feeds, and sorts it by
+// published date. A feed whose feedIntervals override hasn't elapsed since its last fetch
+// is skipped in favor of its previously-fetched items, so feeds can be polled less often
+// than the widget's own refreshInterval
 func (widget *Widget) Fetch(feedURLs []string) ([]*FeedItem, error) {
-	data := []*FeedItem{}
-
 	for _, feedURL := range feedURLs {
+		if !widget.feedIsDue(feedURL) {
+			continue
+		}
+
 		feedItems, err := widget.fetchForFeed(feedURL)
 		if err != nil {
 			return nil, err
 		}
 
-		for _, feedItem := range feedItems {
-			data = append(data, feedItem)
-		}
+		widget.feedItems[feedURL] = feedItems
+		widget.lastFetched[feedURL] = time.Now()
+	}
+
+	data := []*FeedItem{}
+	for _, feedURL := range feedURLs {
+		data = append(data, widget.feedItems[feedURL]...)
 	}
 
 	data = widget.sort(data)
 
+	if widget.settings.maxItems > 0 && len(data) > widget.settings.maxItems {
+		data = data[:widget.settings.maxItems]
+	}
+
 	return data, nil
 }
 
@@ -96,6 +118,22 @@ func (widget *Widget) Render() {
 
 /* -------------------- Unexported Functions -------------------- */
 
+// feedIsDue returns whether feedURL is due to be refetched: true if it has never been
+// fetched, or if its feedIntervals override (if any) has elapsed since its last fetch
+func (widget *Widget) feedIsDue(feedURL string) bool {
+	lastFetched, ok := widget.lastFetched[feedURL]
+	if !ok {
+		return true
+	}
+
+	interval, ok := widget.settings.feedIntervals[feedURL]
+	if !ok {
+		return true
+	}
+
+	return time.Since(lastFetched) >= time.Duration(interval)*time.Second
+}
+
 func (widget *Widget) fetchForFeed(feedURL string) ([]*FeedItem, error) {
 	feed, err := widget.parser.ParseURL(feedURL)
 	if err != nil {
@@ -113,7 +151,8 @@ func (widget *Widget) fetchForFeed(feedURL string) ([]*FeedItem, error) {
 
 		feedItem := &FeedItem{
 			item:   gofeedItem,
-			viewed: false,
+			source: feed.Title,
+			viewed: widget.readLinks[gofeedItem.Link],
 		}
 
 		feedItems = append(feedItems, feedItem)
@@ -145,9 +184,10 @@ func (widget *Widget) content() (string, string, bool) {
 		}
 
 		row := fmt.Sprintf(
-			"[%s]%2d. %s[white]",
+			"[%s]%2d. %-16s %s[white]",
 			rowColor,
 			idx+1,
+			utils.Truncate(feedItem.source, 16, true),
 			feedItem.item.Title,
 		)
 
@@ -176,6 +216,9 @@ func (widget *Widget) openStory() {
 		story := widget.stories[sel]
 		story.viewed = true
 
+		widget.readLinks[story.item.Link] = true
+		widget.saveReadLinks()
+
 		utils.OpenFile(story.item.Link)
 	}
 }
@@ -38,6 +38,10 @@ func (widget *Widget) content() (string, string, bool) {
 	str += widget.displayStats(repo)
 	str += fmt.Sprintf("\n [%s]Open Review Requests[white]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayMyReviewRequests(repo, username)
+	if widget.settings.reviewRequested {
+		str += fmt.Sprintf("\n [%s]Review Requested For Me[white]\n", widget.settings.common.Colors.Subheading)
+		str += widget.displayReviewRequestedForMe(repo)
+	}
 	str += fmt.Sprintf("\n [%s]My Pull Requests[white]\n", widget.settings.common.Colors.Subheading)
 	str += widget.displayMyPullRequests(repo, username)
 	for _, customQuery := range widget.settings.customQueries {
@@ -61,7 +65,7 @@ func (widget *Widget) displayMyPullRequests(repo *GithubRepo, username string) s
 
 	str := ""
 	for idx, pr := range prs {
-		str += fmt.Sprintf(` %s[green]["%d"]%4d[""][white] %s`, widget.mergeString(pr), maxItems+idx, *pr.Number, *pr.Title)
+		str += fmt.Sprintf(` %s%s[green]["%d"]%4d[""][white] %s`, widget.mergeString(pr), widget.checkGlyph(repo, pr), maxItems+idx, *pr.Number, *pr.Title)
 		str += "\n"
 		widget.Items = append(widget.Items, *pr.Number)
 	}
@@ -98,6 +102,29 @@ func (widget *Widget) displayCustomQuery(repo *GithubRepo, filter string, perPag
 	return str
 }
 
+// displayReviewRequestedForMe renders the pull requests returned by the review-requested:@me
+// search, separately from the locally-filtered "Open Review Requests" section above
+func (widget *Widget) displayReviewRequestedForMe(repo *GithubRepo) string {
+	res := repo.reviewRequestedForMe()
+
+	if res == nil || len(res.Issues) == 0 {
+		return " [grey]none[white]\n"
+	}
+
+	maxItems := widget.GetItemCount()
+
+	str := ""
+	for idx, issue := range res.Issues {
+		str += fmt.Sprintf(` [green]["%d"]%4d[""][white] %s`, maxItems+idx, *issue.Number, *issue.Title)
+		str += "\n"
+		widget.Items = append(widget.Items, *issue.Number)
+	}
+
+	widget.SetItemCount(maxItems + len(res.Issues))
+
+	return str
+}
+
 func (widget *Widget) displayMyReviewRequests(repo *GithubRepo, username string) string {
 	prs := repo.myReviewRequests(username)
 
@@ -151,3 +178,12 @@ func (widget *Widget) mergeString(pr *github.PullRequest) string {
 	}
 	return "? "
 }
+
+// checkGlyph returns the review/CI status glyph for a pull request, or "" if showChecks
+// is disabled
+func (widget *Widget) checkGlyph(repo *GithubRepo, pr *github.PullRequest) string {
+	if !widget.settings.showChecks {
+		return ""
+	}
+	return repo.prCheckGlyph(pr)
+}
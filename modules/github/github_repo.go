@@ -12,10 +12,9 @@ import (
 
 const (
 	pullRequestsPath = "/pulls"
-	issuesPath = "/issues"
+	issuesPath       = "/issues"
 )
 
-
 // GithubRepo defines a new GithubRepo structure
 type GithubRepo struct {
 	apiKey    string
@@ -185,6 +184,78 @@ func (repo *GithubRepo) myReviewRequests(username string) []*ghb.PullRequest {
 	return prs
 }
 
+// prCheckGlyph returns a colored glyph summarizing the pull request's review decision and
+// combined CI status: red if changes are requested or a check failed, green if it's approved
+// and every check passed, yellow while either is still pending
+func (repo *GithubRepo) prCheckGlyph(pr *ghb.PullRequest) string {
+	github, err := repo.githubClient()
+	if err != nil {
+		return "? "
+	}
+
+	reviewState := repo.reviewDecision(github, pr)
+	checkState := repo.combinedCheckState(github, pr)
+
+	if reviewState == "changes_requested" || checkState == "failure" {
+		return "[red]✗[white] "
+	}
+
+	if reviewState == "approved" && checkState == "success" {
+		return "[green]✓[white] "
+	}
+
+	return "[yellow]•[white] "
+}
+
+// reviewDecision looks at the most recent review left by each reviewer and returns
+// "changes_requested" if any of them are still asking for changes, "approved" if at least
+// one approved and none are blocking, or "" if nobody has reviewed yet
+func (repo *GithubRepo) reviewDecision(github *ghb.Client, pr *ghb.PullRequest) string {
+	reviews, _, err := github.PullRequests.ListReviews(context.Background(), repo.Owner, repo.Name, pr.GetNumber(), &ghb.ListOptions{})
+	if err != nil {
+		return ""
+	}
+
+	latestByReviewer := map[string]string{}
+	for _, review := range reviews {
+		if review.User == nil {
+			continue
+		}
+		latestByReviewer[review.User.GetLogin()] = review.GetState()
+	}
+
+	decision := ""
+	for _, state := range latestByReviewer {
+		if state == "CHANGES_REQUESTED" {
+			return "changes_requested"
+		}
+		if state == "APPROVED" {
+			decision = "approved"
+		}
+	}
+
+	return decision
+}
+
+// combinedCheckState returns the combined status ("success", "pending", "failure", ...) of
+// the pull request's head commit
+func (repo *GithubRepo) combinedCheckState(github *ghb.Client, pr *ghb.PullRequest) string {
+	status, _, err := github.Repositories.GetCombinedStatus(context.Background(), repo.Owner, repo.Name, pr.GetHead().GetSHA(), &ghb.ListOptions{})
+	if err != nil {
+		return ""
+	}
+
+	return status.GetState()
+}
+
+// reviewRequestedForMe returns open pull requests, scoped to this repo, where the
+// authenticated user's review has been requested, using GitHub's search API rather than
+// scanning the already-loaded PullRequests (which only carry the current reviewer list,
+// not a per-user search index)
+func (repo *GithubRepo) reviewRequestedForMe() *ghb.IssuesSearchResult {
+	return repo.customIssueQuery("is:pr is:open review-requested:@me", 0)
+}
+
 func (repo *GithubRepo) customIssueQuery(filter string, perPage int) *ghb.IssuesSearchResult {
 	github, err := repo.githubClient()
 	if err != nil {
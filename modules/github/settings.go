@@ -16,13 +16,15 @@ const (
 type Settings struct {
 	common *cfg.Common
 
-	apiKey        string        `help:"Your GitHub API token."`
-	baseURL       string        `help:"Your GitHub Enterprise API URL." optional:"true"`
-	customQueries []customQuery `help:"Custom queries allow you to filter pull requests and issues however you like. Give the query a title and a filter. Filters can be copied directly from GitHub’s UI." optional:"true"`
-	enableStatus  bool          `help:"Display pull request mergeability status (‘dirty’, ‘clean’, ‘unstable’, ‘blocked’)." optional:"true"`
-	repositories  []string      `help:"A list of github repositories." values:"Example: wtfutil/wtf"`
-	uploadURL     string        `help:"Your GitHub Enterprise upload URL (often the same as API URL)." optional:"true"`
-	username      string        `help:"Your GitHub username. Used to figure out which review requests you’ve been added to."`
+	apiKey          string        `help:"Your GitHub API token."`
+	baseURL         string        `help:"Your GitHub Enterprise API URL." optional:"true"`
+	customQueries   []customQuery `help:"Custom queries allow you to filter pull requests and issues however you like. Give the query a title and a filter. Filters can be copied directly from GitHub’s UI." optional:"true"`
+	enableStatus    bool          `help:"Display pull request mergeability status (‘dirty’, ‘clean’, ‘unstable’, ‘blocked’)." optional:"true"`
+	repositories    []string      `help:"A list of github repositories." values:"Example: wtfutil/wtf"`
+	reviewRequested bool          `help:"Add a section listing open pull requests in the current repository where your review has been requested. Uses GitHub's search API ('review-requested:@me') rather than the pull requests already loaded for the repository." optional:"true"`
+	showChecks      bool          `help:"Display review decision and combined CI check status for each pull request. Costs an extra API call per pull request, so it's opt-in." optional:"true"`
+	uploadURL       string        `help:"Your GitHub Enterprise upload URL (often the same as API URL)." optional:"true"`
+	username        string        `help:"Your GitHub username. Used to figure out which review requests you’ve been added to."`
 }
 
 type customQuery struct {
@@ -36,11 +38,13 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	settings := Settings{
 		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
-		apiKey:       ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_GITHUB_TOKEN"))),
-		baseURL:      ymlConfig.UString("baseURL", os.Getenv("WTF_GITHUB_BASE_URL")),
-		enableStatus: ymlConfig.UBool("enableStatus", false),
-		uploadURL:    ymlConfig.UString("uploadURL", os.Getenv("WTF_GITHUB_UPLOAD_URL")),
-		username:     ymlConfig.UString("username"),
+		apiKey:          ymlConfig.UString("apiKey", ymlConfig.UString("apikey", os.Getenv("WTF_GITHUB_TOKEN"))),
+		baseURL:         ymlConfig.UString("baseURL", os.Getenv("WTF_GITHUB_BASE_URL")),
+		enableStatus:    ymlConfig.UBool("enableStatus", false),
+		reviewRequested: ymlConfig.UBool("reviewRequested", false),
+		showChecks:      ymlConfig.UBool("showChecks", false),
+		uploadURL:       ymlConfig.UString("uploadURL", os.Getenv("WTF_GITHUB_UPLOAD_URL")),
+		username:        ymlConfig.UString("username"),
 	}
 	settings.repositories = cfg.ParseAsMapOrList(ymlConfig, "repositories")
 	settings.customQueries = parseCustomQueries(ymlConfig)
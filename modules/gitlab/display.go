@@ -2,6 +2,8 @@ package gitlab
 
 import (
 	"fmt"
+
+	glb "github.com/xanzy/go-gitlab"
 )
 
 func (widget *Widget) display() {
@@ -61,12 +63,38 @@ func (widget *Widget) displayMyAssignedMergeRequests(project *GitlabProject, use
 
 	str := ""
 	for _, mr := range mrs {
-		str += fmt.Sprintf(" [green]%4d[white] %s\n", mr.IID, mr.Title)
+		str += fmt.Sprintf(" %s[green]%4d[white] %s\n", pipelineGlyph(mr), mr.IID, mr.Title)
 	}
 
 	return str
 }
 
+// pipelineColors maps a GitLab pipeline status to the color its glyph should be drawn in
+var pipelineColors = map[string]string{
+	"success":  "green",
+	"running":  "yellow",
+	"pending":  "yellow",
+	"failed":   "red",
+	"canceled": "grey",
+	"skipped":  "grey",
+}
+
+// pipelineGlyph returns a colored dot summarizing the merge request's pipeline status, or
+// "" if the project has no pipeline configured for it
+func pipelineGlyph(mr *glb.MergeRequest) string {
+	status := mr.Pipeline.Status
+	if status == "" {
+		return ""
+	}
+
+	color, ok := pipelineColors[status]
+	if !ok {
+		color = "white"
+	}
+
+	return fmt.Sprintf("[%s]●[white] ", color)
+}
+
 func (widget *Widget) displayMyAssignedIssues(project *GitlabProject, username string) string {
 	issues := project.myAssignedIssues(username)
 
@@ -0,0 +1,61 @@
+package imap
+
+import (
+	"crypto/tls"
+
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+)
+
+// FolderStatus is a mailbox folder's name and unread message count
+type FolderStatus struct {
+	Name   string
+	Unread uint32
+}
+
+// newClient connects to and authenticates against the configured IMAP server, using
+// STARTTLS if settings.startTLS is set, or a direct TLS connection otherwise
+func newClient(settings *Settings) (*client.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: settings.skipVerify}
+
+	var cli *client.Client
+	var err error
+
+	if settings.startTLS {
+		cli, err = client.Dial(settings.server)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := cli.StartTLS(tlsConfig); err != nil {
+			return nil, err
+		}
+	} else {
+		cli, err = client.DialTLS(settings.server, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cli.Login(settings.username, settings.password); err != nil {
+		return nil, err
+	}
+
+	return cli, nil
+}
+
+// unreadCounts fetches the unread message count for each of the given folders
+func unreadCounts(cli *client.Client, folders []string) ([]FolderStatus, error) {
+	statuses := make([]FolderStatus, 0, len(folders))
+
+	for _, folder := range folders {
+		status, err := cli.Status(folder, []imap.StatusItem{imap.StatusUnseen})
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, FolderStatus{Name: folder, Unread: status.Unseen})
+	}
+
+	return statuses, nil
+}
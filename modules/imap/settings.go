@@ -0,0 +1,42 @@
+package imap
+
+import (
+	"os"
+
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
+)
+
+const (
+	defaultFocusable = false
+	defaultTitle     = "IMAP"
+)
+
+// Settings defines the configuration properties for this module
+type Settings struct {
+	common *cfg.Common
+
+	server     string   `help:"The IMAP server to connect to, in host:port form."`
+	username   string   `help:"The username to authenticate with."`
+	password   string   `help:"The password to authenticate with. Falls back to the WTF_IMAP_PASSWORD environment variable when unset."`
+	folders    []string `help:"The mailbox folders to show unread counts for." values:"A list of strings, ie. ['INBOX', 'Archive']"`
+	startTLS   bool     `help:"Whether or not to connect in plaintext and upgrade to TLS with STARTTLS, rather than connecting over TLS from the start." values:"true or false" optional:"true"`
+	skipVerify bool     `help:"Whether or not to skip TLS certificate verification. Only use this against a trusted internal server." values:"true or false" optional:"true"`
+}
+
+// NewSettingsFromYAML creates a new settings instance from a YAML config block
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		server:     ymlConfig.UString("server", ""),
+		username:   ymlConfig.UString("username", ""),
+		password:   ymlConfig.UString("password", os.Getenv("WTF_IMAP_PASSWORD")),
+		folders:    utils.ToStrs(ymlConfig.UList("folders", []interface{}{"INBOX"})),
+		startTLS:   ymlConfig.UBool("startTLS", false),
+		skipVerify: ymlConfig.UBool("skipVerify", false),
+	}
+
+	return &settings
+}
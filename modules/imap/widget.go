@@ -0,0 +1,78 @@
+package imap
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+// Widget is the container for IMAP unread-count data
+type Widget struct {
+	view.TextWidget
+
+	settings *Settings
+	statuses []FolderStatus
+	err      error
+}
+
+// NewWidget creates a new instance of a widget
+func NewWidget(app *tview.Application, settings *Settings) *Widget {
+	widget := &Widget{
+		TextWidget: view.NewTextWidget(app, settings.common),
+
+		settings: settings,
+	}
+
+	return widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Fetch connects to the configured IMAP server and returns the unread count for each
+// configured folder
+func (widget *Widget) Fetch() ([]FolderStatus, error) {
+	cli, err := newClient(widget.settings)
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Logout()
+
+	return unreadCounts(cli, widget.settings.folders)
+}
+
+// Refresh updates the data for this widget and displays it onscreen
+func (widget *Widget) Refresh() {
+	statuses, err := widget.Fetch()
+
+	if err != nil {
+		widget.err = err
+		widget.statuses = nil
+	} else {
+		widget.err = nil
+		widget.statuses = statuses
+	}
+
+	widget.Redraw(widget.content)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+	if widget.err != nil {
+		return title, widget.err.Error(), true
+	}
+
+	str := ""
+	for _, status := range widget.statuses {
+		color := "white"
+		if status.Unread > 0 {
+			color = "green"
+		}
+
+		str += fmt.Sprintf(" [%s]%-16s %d[white]\n", color, status.Name, status.Unread)
+	}
+
+	return title, str, false
+}
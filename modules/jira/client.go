@@ -3,6 +3,7 @@ package jira
 import (
 	"crypto/tls"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -13,17 +14,19 @@ import (
 func (widget *Widget) IssuesFor(username string, projects []string, jql string) (*SearchResult, error) {
 	query := []string{}
 
-	var projQuery = getProjectQuery(projects)
-	if projQuery != "" {
-		query = append(query, projQuery)
-	}
-
-	if username != "" {
-		query = append(query, buildJql("assignee", username))
-	}
-
+	// A custom jql is used verbatim instead of the constructed project/assignee query, so
+	// users can express queries (epics, labels, sprints, ...) the built query can't
 	if jql != "" {
 		query = append(query, jql)
+	} else {
+		var projQuery = getProjectQuery(projects)
+		if projQuery != "" {
+			query = append(query, projQuery)
+		}
+
+		if username != "" {
+			query = append(query, buildJql("assignee", username))
+		}
 	}
 
 	v := url.Values{}
@@ -50,15 +53,49 @@ func buildJql(key string, value string) string {
 	return fmt.Sprintf("%s = \"%s\"", key, value)
 }
 
+// TransitionsFor fetches the set of statuses the given issue can transition to next
+func (widget *Widget) TransitionsFor(issueKey string) ([]Transition, error) {
+	resp, err := widget.jiraRequest(issueKey + "/transitions")
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := &transitionsResult{}
+	if err := utils.ParseJSON(transitions, resp.Body); err != nil {
+		return nil, err
+	}
+
+	return transitions.Transitions, nil
+}
+
+// TransitionIssue moves the given issue to the status reachable via the given transition ID
+func (widget *Widget) TransitionIssue(issueKey string, transitionID string) error {
+	body := strings.NewReader(fmt.Sprintf(`{"transition":{"id":%q}}`, transitionID))
+
+	_, err := widget.doJiraRequest("POST", "/rest/api/2/issue/"+issueKey+"/transitions", body)
+	return err
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
-func (widget *Widget) jiraRequest(path string) (*http.Response, error) {
+func (widget *Widget) jiraRequest(issueOrSearchPath string) (*http.Response, error) {
+	if strings.HasPrefix(issueOrSearchPath, "/rest/") {
+		return widget.doJiraRequest("GET", issueOrSearchPath, nil)
+	}
+
+	return widget.doJiraRequest("GET", "/rest/api/2/issue/"+issueOrSearchPath, nil)
+}
+
+func (widget *Widget) doJiraRequest(method string, path string, body io.Reader) (*http.Response, error) {
 	url := fmt.Sprintf("%s%s", widget.settings.domain, path)
 
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequest(method, url, body)
 	if err != nil {
 		return nil, err
 	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 	req.SetBasicAuth(widget.settings.email, widget.settings.apiKey)
 
 	httpClient := &http.Client{Transport: &http.Transport{
@@ -73,6 +110,15 @@ func (widget *Widget) jiraRequest(path string) (*http.Response, error) {
 		return nil, err
 	}
 
+	if resp.StatusCode == http.StatusBadRequest {
+		errResp := &jiraErrorResponse{}
+		if parseErr := utils.ParseJSON(errResp, resp.Body); parseErr == nil && len(errResp.ErrorMessages) > 0 {
+			return nil, fmt.Errorf("Jira rejected the request: %s", strings.Join(errResp.ErrorMessages, "; "))
+		}
+
+		return nil, fmt.Errorf(resp.Status)
+	}
+
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
 		return nil, fmt.Errorf(resp.Status)
 	}
@@ -10,6 +10,7 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar("j", widget.Next, "Select next item")
 	widget.SetKeyboardChar("k", widget.Prev, "Select previous item")
 	widget.SetKeyboardChar("o", widget.openItem, "Open item in browser")
+	widget.SetKeyboardChar("t", widget.showTransitionModal, "Transition selected issue's status")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
@@ -6,3 +6,8 @@ type SearchResult struct {
 	Total      int     `json:"total"`
 	Issues     []Issue `json:"issues"`
 }
+
+// jiraErrorResponse is the shape Jira returns on a 400, most commonly for malformed JQL
+type jiraErrorResponse struct {
+	ErrorMessages []string `json:"errorMessages"`
+}
@@ -0,0 +1,75 @@
+package jira
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+)
+
+// showTransitionModal fetches the available transitions for the selected issue and lets the
+// user pick one to move the issue to
+func (widget *Widget) showTransitionModal() {
+	issue := widget.currentIssue()
+	if issue == nil {
+		return
+	}
+
+	transitions, err := widget.TransitionsFor(issue.Key)
+	if err != nil {
+		widget.showTransitionError(err)
+		return
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage("transition")
+		widget.app.SetFocus(widget.View)
+	}
+
+	list := tview.NewList()
+	list.ShowSecondaryText(false)
+
+	for _, transition := range transitions {
+		transitionID := transition.ID
+		list.AddItem(transition.To.Name, "", 0, func() {
+			closeFunc()
+			widget.transitionIssue(issue.Key, transitionID)
+		})
+	}
+
+	list.SetDoneFunc(closeFunc)
+
+	frame := tview.NewFrame(list)
+	frame.SetTitle(fmt.Sprintf("  Transition %s  ", issue.Key))
+	frame.SetBorder(true)
+
+	widget.pages.AddPage("transition", frame, true, true)
+	widget.app.SetFocus(list)
+}
+
+// transitionIssue posts the chosen transition and refreshes the issue list, or shows an
+// error modal if the transition failed
+func (widget *Widget) transitionIssue(issueKey string, transitionID string) {
+	if err := widget.TransitionIssue(issueKey, transitionID); err != nil {
+		widget.showTransitionError(err)
+		return
+	}
+
+	widget.Refresh()
+}
+
+func (widget *Widget) showTransitionError(err error) {
+	closeFunc := func() {
+		widget.pages.RemovePage("transitionError")
+		widget.app.SetFocus(widget.View)
+	}
+
+	modal := tview.NewModal()
+	modal.SetText(err.Error())
+	modal.AddButtons([]string{"OK"})
+	modal.SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+		closeFunc()
+	})
+
+	widget.pages.AddPage("transitionError", modal, false, true)
+	widget.app.SetFocus(modal)
+}
@@ -0,0 +1,15 @@
+package jira
+
+// Transition is one of the statuses an issue can move to next, as returned by Jira's
+// issue/{key}/transitions endpoint
+type Transition struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	To   struct {
+		Name string `json:"name"`
+	} `json:"to"`
+}
+
+type transitionsResult struct {
+	Transitions []Transition `json:"transitions"`
+}
@@ -12,6 +12,8 @@ type Widget struct {
 	view.KeyboardWidget
 	view.ScrollableWidget
 
+	app      *tview.Application
+	pages    *tview.Pages
 	result   *SearchResult
 	settings *Settings
 	err      error
@@ -22,6 +24,8 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
 		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
 
+		app:      app,
+		pages:    pages,
 		settings: settings,
 	}
 
@@ -62,13 +66,21 @@ func (widget *Widget) Render() {
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) openItem() {
-	sel := widget.GetSelected()
-	if sel >= 0 && widget.result != nil && sel < len(widget.result.Issues) {
-		issue := &widget.result.Issues[sel]
+	issue := widget.currentIssue()
+	if issue != nil {
 		utils.OpenFile(widget.settings.domain + "/browse/" + issue.Key)
 	}
 }
 
+func (widget *Widget) currentIssue() *Issue {
+	sel := widget.GetSelected()
+	if sel < 0 || widget.result == nil || sel >= len(widget.result.Issues) {
+		return nil
+	}
+
+	return &widget.result.Issues[sel]
+}
+
 const MaxIssueTypeLength = 7
 const MaxStatusNameLength = 14
 
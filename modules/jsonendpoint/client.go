@@ -0,0 +1,113 @@
+package jsonendpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+/* -------------------- Exported Functions -------------------- */
+
+// Fetch GETs the configured endpoint and extracts each configured field from the
+// resulting JSON document, keyed by its label
+func (widget *Widget) Fetch() (map[string]interface{}, error) {
+	req, err := http.NewRequest("GET", widget.settings.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range widget.settings.headers {
+		req.Header.Set(key, value)
+	}
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var document interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	for label, path := range widget.settings.fields {
+		fields[label] = valueAt(document, path)
+	}
+
+	return fields, nil
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// valueAt walks a dot-separated path (e.g. "data.items.0.name") through a decoded JSON
+// document, descending into maps by key and into slices by numeric index. It returns nil
+// if any segment of the path can't be resolved
+func valueAt(document interface{}, path string) interface{} {
+	current := document
+
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			continue
+		}
+
+		switch node := current.(type) {
+		case map[string]interface{}:
+			value, ok := node[segment]
+			if !ok {
+				return nil
+			}
+			current = value
+		case []interface{}:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil
+			}
+			current = node[idx]
+		default:
+			return nil
+		}
+	}
+
+	return current
+}
+
+// rowCount returns the number of rows to render: the length of the longest list-valued
+// field, or 1 if no field resolved to a list
+func rowCount(fields map[string]interface{}) int {
+	rows := 1
+
+	for _, value := range fields {
+		if list, ok := value.([]interface{}); ok && len(list) > rows {
+			rows = len(list)
+		}
+	}
+
+	return rows
+}
+
+// cellAt returns the display text for a field's value at the given row: the matching
+// element if the field is a list, the field's value repeated on every row otherwise
+func cellAt(value interface{}, row int) string {
+	if list, ok := value.([]interface{}); ok {
+		if row >= len(list) {
+			return ""
+		}
+		return stringify(list[row])
+	}
+
+	return stringify(value)
+}
+
+func stringify(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", value)
+}
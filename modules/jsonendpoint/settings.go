@@ -0,0 +1,44 @@
+package jsonendpoint
+
+import (
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	defaultFocusable = false
+	defaultTitle     = "JSON Endpoint"
+)
+
+type Settings struct {
+	common *cfg.Common
+
+	url     string            `help:"The URL of the JSON endpoint to query."`
+	headers map[string]string `help:"Optional HTTP headers to send with the request, useful for auth." optional:"true"`
+	fields  map[string]string `help:"A map of label to dot-separated path into the response JSON, e.g. {Status: data.status}."`
+}
+
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		url: ymlConfig.UString("url"),
+	}
+
+	settings.headers = stringMap(ymlConfig, "headers")
+	settings.fields = stringMap(ymlConfig, "fields")
+
+	return &settings
+}
+
+func stringMap(ymlConfig *config.Config, key string) map[string]string {
+	result := map[string]string{}
+
+	for k, v := range ymlConfig.UMap(key) {
+		if strValue, ok := v.(string); ok {
+			result[k] = strValue
+		}
+	}
+
+	return result
+}
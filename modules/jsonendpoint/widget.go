@@ -0,0 +1,60 @@
+package jsonendpoint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+type Widget struct {
+	view.TextWidget
+
+	settings *Settings
+}
+
+func NewWidget(app *tview.Application, settings *Settings) *Widget {
+	widget := Widget{
+		TextWidget: view.NewTextWidget(app, settings.common),
+
+		settings: settings,
+	}
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+func (widget *Widget) Refresh() {
+	widget.Redraw(widget.content)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	fields, err := widget.Fetch()
+	if err != nil {
+		return title, err.Error(), true
+	}
+
+	labels := make([]string, 0, len(fields))
+	for label := range fields {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	var content string
+	for row := 0; row < rowCount(fields); row++ {
+		cells := make([]string, 0, len(labels))
+		for _, label := range labels {
+			cells = append(cells, fmt.Sprintf("[green]%s:[white] %s", label, cellAt(fields[label], row)))
+		}
+		content += fmt.Sprintf(" %s\n", strings.Join(cells, "  "))
+	}
+
+	return title, content, false
+}
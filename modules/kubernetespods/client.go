@@ -0,0 +1,66 @@
+package kubernetespods
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	// Includes authentication modules for various Kubernetes providers
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// getKubeClient returns a Kubernetes clientset built from the widget's kubeconfig and
+// context settings
+func (widget *Widget) getKubeClient() (kubernetes.Interface, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if widget.settings.kubeconfig != "" {
+		loadingRules.ExplicitPath = widget.settings.kubeconfig
+	}
+
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: widget.settings.context}
+
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(config)
+}
+
+// fetchPods lists the pods in the configured namespace, filtered by the configured
+// label selector
+func (widget *Widget) fetchPods() ([]corev1.Pod, error) {
+	client, err := widget.getKubeClient()
+	if err != nil {
+		return nil, err
+	}
+
+	podList, err := client.CoreV1().Pods(widget.settings.namespace).List(metav1.ListOptions{
+		LabelSelector: widget.settings.labelSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return podList.Items, nil
+}
+
+// fetchLogs returns the trailing logLines lines of the given pod's logs
+func (widget *Widget) fetchLogs(podName string) (string, error) {
+	client, err := widget.getKubeClient()
+	if err != nil {
+		return "", err
+	}
+
+	tailLines := int64(widget.settings.logLines)
+
+	logs, err := client.CoreV1().
+		Pods(widget.settings.namespace).
+		GetLogs(podName, &corev1.PodLogOptions{TailLines: &tailLines}).
+		DoRaw()
+	if err != nil {
+		return "", err
+	}
+
+	return string(logs), nil
+}
@@ -0,0 +1,16 @@
+package kubernetespods
+
+import "github.com/gdamore/tcell"
+
+func (widget *Widget) initializeKeyboardControls() {
+	widget.InitializeCommonControls(widget.Refresh)
+
+	widget.SetKeyboardChar("j", widget.Next, "Select next pod")
+	widget.SetKeyboardChar("k", widget.Prev, "Select previous pod")
+	widget.SetKeyboardChar("l", widget.showLogs, "View the selected pod's logs")
+	widget.SetKeyboardChar("u", widget.Unselect, "Clear selection")
+
+	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next pod")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous pod")
+	widget.SetKeyboardKey(tcell.KeyEnter, widget.showLogs, "View the selected pod's logs")
+}
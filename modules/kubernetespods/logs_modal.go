@@ -0,0 +1,37 @@
+package kubernetespods
+
+import (
+	"fmt"
+
+	"github.com/wtfutil/wtf/view"
+)
+
+const logsPage = "kubernetesPodLogs"
+
+// showLogs displays the selected pod's recent logs in a scrollable modal
+func (widget *Widget) showLogs() {
+	pod := widget.currentPod()
+	if pod == nil {
+		return
+	}
+
+	closeFunc := func() {
+		widget.pages.RemovePage(logsPage)
+		widget.app.SetFocus(widget.View)
+	}
+
+	logs, err := widget.fetchLogs(pod.Name)
+	if err != nil {
+		logs = fmt.Sprintf("Could not fetch logs: %v", err)
+	}
+
+	modal := view.NewBillboardModal(logs, closeFunc)
+	modal.SetTitle(fmt.Sprintf("  %s  ", pod.Name))
+
+	widget.pages.AddPage(logsPage, modal, false, true)
+	widget.app.SetFocus(modal)
+
+	widget.app.QueueUpdateDraw(func() {
+		widget.app.Draw()
+	})
+}
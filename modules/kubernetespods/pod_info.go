@@ -0,0 +1,69 @@
+package kubernetespods
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// podStatus returns the pod's displayable status: its phase, or the waiting reason of a
+// crashing container (e.g. "CrashLoopBackOff") when one is present, since that's usually
+// more useful than the generic "Running" phase it's masking
+func podStatus(pod corev1.Pod) string {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason != "" {
+			return containerStatus.State.Waiting.Reason
+		}
+	}
+
+	return string(pod.Status.Phase)
+}
+
+// podRestarts returns the total restart count across all of the pod's containers
+func podRestarts(pod corev1.Pod) int32 {
+	var restarts int32
+
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		restarts += containerStatus.RestartCount
+	}
+
+	return restarts
+}
+
+// podAge returns a short human-readable duration since the pod was created
+func podAge(pod corev1.Pod) string {
+	if pod.CreationTimestamp.IsZero() {
+		return "unknown"
+	}
+
+	age := time.Since(pod.CreationTimestamp.Time)
+
+	switch {
+	case age < time.Minute:
+		return fmt.Sprintf("%ds", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf("%dm", int(age.Minutes()))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(age.Hours()/24))
+	}
+}
+
+// phaseColor returns the row color for a pod based on its status, prioritizing a
+// crashing container's status over the pod's overall phase
+func phaseColor(pod corev1.Pod) string {
+	switch podStatus(pod) {
+	case string(corev1.PodRunning):
+		return "green"
+	case string(corev1.PodPending):
+		return "yellow"
+	case string(corev1.PodSucceeded):
+		return "blue"
+	case string(corev1.PodFailed), "CrashLoopBackOff":
+		return "red"
+	default:
+		return "white"
+	}
+}
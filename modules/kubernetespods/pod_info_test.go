@@ -0,0 +1,83 @@
+package kubernetespods
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_podStatus(t *testing.T) {
+	testCases := []struct {
+		name string
+		pod  corev1.Pod
+		want string
+	}{
+		{
+			name: "Running, no waiting containers",
+			pod:  corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			want: "Running",
+		},
+		{
+			name: "Running phase, but a container is crash-looping",
+			pod: corev1.Pod{
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+					ContainerStatuses: []corev1.ContainerStatus{
+						{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+					},
+				},
+			},
+			want: "CrashLoopBackOff",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, podStatus(tt.pod))
+		})
+	}
+}
+
+func Test_podRestarts(t *testing.T) {
+	pod := corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{RestartCount: 2},
+				{RestartCount: 3},
+			},
+		},
+	}
+
+	assert.Equal(t, int32(5), podRestarts(pod))
+}
+
+func Test_podAge(t *testing.T) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-90 * time.Minute)),
+		},
+	}
+
+	assert.Equal(t, "1h", podAge(pod))
+}
+
+func Test_phaseColor(t *testing.T) {
+	testCases := []struct {
+		name string
+		pod  corev1.Pod
+		want string
+	}{
+		{"Running", corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodRunning}}, "green"},
+		{"Pending", corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodPending}}, "yellow"},
+		{"Failed", corev1.Pod{Status: corev1.PodStatus{Phase: corev1.PodFailed}}, "red"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, phaseColor(tt.pod))
+		})
+	}
+}
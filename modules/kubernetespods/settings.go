@@ -0,0 +1,37 @@
+package kubernetespods
+
+import (
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	defaultFocusable = true
+	defaultTitle     = "Kubernetes Pods"
+)
+
+// Settings is the configuration for the kubernetespods widget
+type Settings struct {
+	common *cfg.Common
+
+	kubeconfig    string `help:"Location of a kubeconfig file." optional:"true"`
+	context       string `help:"The kubeconfig context to use. Falls back to the kubeconfig's current context." optional:"true"`
+	namespace     string `help:"The namespace to list pods from." optional:"true"`
+	labelSelector string `help:"A Kubernetes label selector (e.g. \"app=web\") used to filter the pod list." optional:"true"`
+	logLines      int    `help:"The number of trailing log lines to fetch when viewing a pod's logs." optional:"true"`
+}
+
+// NewSettingsFromYAML creates a new Settings from the module's YAML config
+func NewSettingsFromYAML(name string, moduleConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, moduleConfig, globalConfig),
+
+		kubeconfig:    moduleConfig.UString("kubeconfig"),
+		context:       moduleConfig.UString("context"),
+		namespace:     moduleConfig.UString("namespace"),
+		labelSelector: moduleConfig.UString("labelSelector"),
+		logLines:      moduleConfig.UInt("logLines", 200),
+	}
+
+	return &settings
+}
@@ -0,0 +1,114 @@
+package kubernetespods
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/view"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Widget is the container for the kubernetespods widget's state
+type Widget struct {
+	view.KeyboardWidget
+	view.ScrollableWidget
+
+	app      *tview.Application
+	pages    *tview.Pages
+	pods     []corev1.Pod
+	err      error
+	settings *Settings
+}
+
+// NewWidget creates and returns an instance of the kubernetespods widget
+func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *Widget {
+	widget := Widget{
+		KeyboardWidget:   view.NewKeyboardWidget(app, pages, settings.common),
+		ScrollableWidget: view.NewScrollableWidget(app, settings.common),
+
+		app:      app,
+		pages:    pages,
+		settings: settings,
+	}
+
+	widget.initializeKeyboardControls()
+	widget.View.SetInputCapture(widget.InputCapture)
+	widget.View.SetWrap(false)
+
+	widget.KeyboardWidget.SetView(widget.View)
+	widget.SetRenderFunction(widget.display)
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Refresh fetches the current pod list and redraws the widget
+func (widget *Widget) Refresh() {
+	pods, err := widget.fetchPods()
+
+	widget.err = err
+	widget.pods = pods
+	widget.SetItemCount(len(widget.pods))
+
+	widget.display()
+}
+
+// HelpText returns the help text for this widget
+func (widget *Widget) HelpText() string {
+	return widget.KeyboardWidget.HelpText()
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// currentPod returns the currently-selected pod, or nil if there isn't one
+func (widget *Widget) currentPod() *corev1.Pod {
+	if widget.Selected < 0 || widget.Selected >= len(widget.pods) {
+		return nil
+	}
+
+	return &widget.pods[widget.Selected]
+}
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	if widget.err != nil {
+		return title, fmt.Sprintf("[red]%s[white]", widget.err.Error()), true
+	}
+
+	if len(widget.pods) == 0 {
+		return title, " No pods found\n", false
+	}
+
+	str := fmt.Sprintf(
+		" [%s]%-40s %-18s %-9s %s\n\n",
+		widget.settings.common.Colors.Subheading,
+		"NAME", "STATUS", "RESTARTS", "AGE",
+	)
+
+	for idx, pod := range widget.pods {
+		rowColor := widget.RowColor(idx)
+		phaseColor := phaseColor(pod)
+
+		row := fmt.Sprintf(
+			"[%s]%-40s [%s]%-18s[%s] %-9d %s",
+			rowColor,
+			utils.Truncate(pod.Name, 40, true),
+			phaseColor,
+			podStatus(pod),
+			rowColor,
+			podRestarts(pod),
+			podAge(pod),
+		)
+
+		str += utils.HighlightableHelper(widget.View, row, idx, 76)
+	}
+
+	return title, str, false
+}
+
+func (widget *Widget) display() {
+	widget.Redraw(widget.content)
+}
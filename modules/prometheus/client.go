@@ -0,0 +1,117 @@
+package prometheus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Series is a single labelled time series returned by an instant query,
+// along with the value Prometheus reported for it
+type Series struct {
+	Labels map[string]string
+	Value  string
+}
+
+type queryResponse struct {
+	Status    string    `json:"status"`
+	Data      queryData `json:"data"`
+	ErrorType string    `json:"errorType"`
+	Error     string    `json:"error"`
+}
+
+type queryData struct {
+	ResultType string          `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+}
+
+type vectorResult struct {
+	Metric map[string]string `json:"metric"`
+	Value  [2]interface{}    `json:"value"`
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Fetch runs the configured PromQL instant query against the Prometheus server and
+// returns the resulting series
+func (widget *Widget) Fetch(query string) ([]Series, error) {
+	req, err := http.NewRequest("GET", strings.TrimRight(widget.settings.url, "/")+"/api/v1/query", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	queryStr := req.URL.Query()
+	queryStr.Set("query", query)
+	req.URL.RawQuery = queryStr.Encode()
+
+	for key, value := range widget.settings.headers {
+		req.Header.Set(key, value)
+	}
+
+	client := &http.Client{}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := &queryResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, err
+	}
+
+	if response.Status != "success" {
+		return nil, fmt.Errorf("%s: %s", response.ErrorType, response.Error)
+	}
+
+	return parseResult(response.Data)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func parseResult(data queryData) ([]Series, error) {
+	switch data.ResultType {
+	case "vector":
+		var results []vectorResult
+		if err := json.Unmarshal(data.Result, &results); err != nil {
+			return nil, err
+		}
+
+		series := make([]Series, 0, len(results))
+		for _, result := range results {
+			value, _ := result.Value[1].(string)
+			series = append(series, Series{Labels: result.Metric, Value: value})
+		}
+
+		return series, nil
+	case "scalar":
+		var result [2]interface{}
+		if err := json.Unmarshal(data.Result, &result); err != nil {
+			return nil, err
+		}
+
+		value, _ := result[1].(string)
+		return []Series{{Value: value}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported result type %q", data.ResultType)
+	}
+}
+
+// LabelString renders a series' labels as a sorted, comma-separated "key=value" list
+func (series Series) LabelString() string {
+	keys := make([]string, 0, len(series.Labels))
+	for key := range series.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, series.Labels[key]))
+	}
+
+	return strings.Join(pairs, ", ")
+}
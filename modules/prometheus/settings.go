@@ -0,0 +1,44 @@
+package prometheus
+
+import (
+	"github.com/olebedev/config"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+const (
+	defaultFocusable = false
+	defaultTitle     = "Prometheus"
+)
+
+type Settings struct {
+	common *cfg.Common
+
+	url     string            `help:"The base URL of the Prometheus server to query." values:"A valid URL, e.g. http://localhost:9090"`
+	query   string            `help:"The PromQL instant query to run."`
+	headers map[string]string `help:"Optional HTTP headers to send with the request, useful for auth." optional:"true"`
+}
+
+func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
+	settings := Settings{
+		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
+
+		url:   ymlConfig.UString("url"),
+		query: ymlConfig.UString("query"),
+	}
+
+	settings.headers = parseHeaders(ymlConfig)
+
+	return &settings
+}
+
+func parseHeaders(ymlConfig *config.Config) map[string]string {
+	headers := map[string]string{}
+
+	for key, value := range ymlConfig.UMap("headers") {
+		if strValue, ok := value.(string); ok {
+			headers[key] = strValue
+		}
+	}
+
+	return headers
+}
@@ -0,0 +1,61 @@
+package prometheus
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/view"
+)
+
+type Widget struct {
+	view.TextWidget
+
+	settings *Settings
+}
+
+func NewWidget(app *tview.Application, settings *Settings) *Widget {
+	widget := Widget{
+		TextWidget: view.NewTextWidget(app, settings.common),
+
+		settings: settings,
+	}
+
+	return &widget
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+func (widget *Widget) Refresh() {
+	widget.Redraw(widget.content)
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+func (widget *Widget) content() (string, string, bool) {
+	title := widget.CommonSettings().Title
+
+	series, err := widget.Fetch(widget.settings.query)
+	if err != nil {
+		return title, err.Error(), true
+	}
+
+	if len(series) == 0 {
+		return title, " no results\n", false
+	}
+
+	var content string
+	for _, s := range series {
+		content += widget.formattedSeries(s)
+	}
+
+	return title, content, false
+}
+
+func (widget *Widget) formattedSeries(series Series) string {
+	labels := series.LabelString()
+	if labels == "" {
+		return fmt.Sprintf(" %s\n", series.Value)
+	}
+
+	return fmt.Sprintf(" [green]%s[white] %s\n", labels, series.Value)
+}
@@ -0,0 +1,124 @@
+package spotifyweb
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"strings"
+
+	"github.com/zmb3/spotify"
+)
+
+const (
+	albumArtColumns = 20
+	albumArtRows    = 10
+)
+
+// albumArt caches the rendered ASCII-block art for the last album cover that was
+// fetched, so the same image isn't re-downloaded and re-rendered on every refresh
+type albumArt struct {
+	imageURL string
+	rendered string
+}
+
+// renderAlbumArt returns the album cover at imageURL rendered as a grid of colored block
+// characters, using the cached render if imageURL hasn't changed since the last call.
+// Terminals that support sixel graphics aren't given a sixel payload here: tview's
+// TextView has no path for passing raw terminal escape sequences through its own
+// tag-parsing renderer, so colored ASCII blocks are the only rendering this widget can
+// safely emit regardless of terminal capability
+func (widget *Widget) renderAlbumArt(imageURL string) string {
+	if imageURL == "" {
+		return ""
+	}
+
+	if widget.albumArt.imageURL == imageURL && widget.albumArt.rendered != "" {
+		return widget.albumArt.rendered
+	}
+
+	img, err := fetchImage(imageURL)
+	if err != nil {
+		return widget.albumArt.rendered
+	}
+
+	rendered := asciiBlocks(img, albumArtColumns, albumArtRows)
+
+	widget.albumArt = albumArt{
+		imageURL: imageURL,
+		rendered: rendered,
+	}
+
+	return rendered
+}
+
+// fetchImage downloads and decodes the image at imageURL
+func fetchImage(imageURL string) (image.Image, error) {
+	buf := &bytes.Buffer{}
+
+	if err := (spotify.Image{URL: imageURL}).Download(buf); err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(buf)
+	return img, err
+}
+
+// asciiBlocks downsamples img to cols x rows via nearest-neighbor sampling and renders
+// it as colored lower-half-block characters, two source rows per line of text: the
+// background color carries the top pixel, the foreground the bottom one
+func asciiBlocks(img image.Image, cols, rows int) string {
+	bounds := img.Bounds()
+	width := bounds.Dx()
+	height := bounds.Dy()
+
+	if width == 0 || height == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+
+	for row := 0; row < rows; row++ {
+		topY := bounds.Min.Y + (row*2*height)/(rows*2)
+		bottomY := bounds.Min.Y + ((row*2+1)*height)/(rows*2)
+
+		for col := 0; col < cols; col++ {
+			x := bounds.Min.X + (col*width)/cols
+
+			topColor := hexColor(img.At(x, topY))
+			bottomColor := hexColor(img.At(x, bottomY))
+
+			out.WriteString(fmt.Sprintf("[%s:%s]▄[-:-]", bottomColor, topColor))
+		}
+
+		out.WriteString("\n")
+	}
+
+	return out.String()
+}
+
+// hexColor converts c to a "#rrggbb" string suitable for a tview color tag
+func hexColor(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+}
+
+// smallestImageURL returns the URL of the smallest image in images, to keep the amount
+// of image data fetched for a tiny ASCII-block render to a minimum. Returns "" if images
+// is empty
+func smallestImageURL(images []spotify.Image) string {
+	if len(images) == 0 {
+		return ""
+	}
+
+	smallest := images[0]
+	for _, img := range images[1:] {
+		if img.Width > 0 && img.Width < smallest.Width {
+			smallest = img
+		}
+	}
+
+	return smallest.URL
+}
@@ -6,43 +6,89 @@ import (
 	"github.com/gdamore/tcell"
 )
 
+const seekIncrementMs = 15000
+
 func (widget *Widget) initializeKeyboardControls() {
 	widget.InitializeCommonControls(widget.Refresh)
 
-	widget.SetKeyboardChar("h", widget.selectPrevious, "Select previous item")
-	widget.SetKeyboardChar("l", widget.selectNext, "Select next item")
+	widget.SetKeyboardChar("h", widget.selectPrevious, "Previous track")
+	widget.SetKeyboardChar("l", widget.selectNext, "Next track")
 	widget.SetKeyboardChar(" ", widget.playPause, "Play/pause")
 	widget.SetKeyboardChar("s", widget.toggleShuffle, "Toggle shuffle")
 
-	widget.SetKeyboardKey(tcell.KeyDown, widget.selectNext, "Select next item")
-	widget.SetKeyboardKey(tcell.KeyUp, widget.selectPrevious, "Select previous item")
+	widget.SetKeyboardKey(tcell.KeyDown, widget.selectNext, "Next track")
+	widget.SetKeyboardKey(tcell.KeyUp, widget.selectPrevious, "Previous track")
+	widget.SetKeyboardKey(tcell.KeyRight, widget.seekForward, "Seek forward 15s")
+	widget.SetKeyboardKey(tcell.KeyLeft, widget.seekBackward, "Seek back 15s")
 }
 
-func (widget *Widget) selectPrevious() {
-	widget.client.Previous()
+// hasActiveDevice returns TRUE if the most recently fetched player state reports an
+// active playback device, FALSE if there's nothing to control
+func (widget *Widget) hasActiveDevice() bool {
+	return widget.playerState != nil && widget.playerState.Device.ID != ""
+}
+
+// withActiveDevice runs action if there's an active playback device, otherwise it sets
+// deviceError and refreshes the display without touching the Spotify API
+func (widget *Widget) withActiveDevice(action func()) {
+	if !widget.hasActiveDevice() {
+		widget.deviceError = "No active Spotify device found. Start playing on a device first."
+		widget.Refresh()
+		return
+	}
+
+	widget.deviceError = ""
+	action()
+
 	time.Sleep(time.Millisecond * 500)
 	widget.Refresh()
 }
 
+func (widget *Widget) selectPrevious() {
+	widget.withActiveDevice(func() {
+		widget.client.Previous()
+	})
+}
+
 func (widget *Widget) selectNext() {
-	widget.client.Next()
-	time.Sleep(time.Millisecond * 500)
-	widget.Refresh()
+	widget.withActiveDevice(func() {
+		widget.client.Next()
+	})
 }
 
 func (widget *Widget) playPause() {
-	if widget.playerState.CurrentlyPlaying.Playing {
-		widget.client.Pause()
-	} else {
-		widget.client.Play()
-	}
-	time.Sleep(time.Millisecond * 500)
-	widget.Refresh()
+	widget.withActiveDevice(func() {
+		if widget.playerState.CurrentlyPlaying.Playing {
+			widget.client.Pause()
+		} else {
+			widget.client.Play()
+		}
+	})
 }
 
 func (widget *Widget) toggleShuffle() {
-	widget.playerState.ShuffleState = !widget.playerState.ShuffleState
-	widget.client.Shuffle(widget.playerState.ShuffleState)
-	time.Sleep(time.Millisecond * 500)
-	widget.Refresh()
+	widget.withActiveDevice(func() {
+		widget.playerState.ShuffleState = !widget.playerState.ShuffleState
+		widget.client.Shuffle(widget.playerState.ShuffleState)
+	})
+}
+
+// seekForward jumps the current track ahead by seekIncrementMs
+func (widget *Widget) seekForward() {
+	widget.withActiveDevice(func() {
+		position := widget.playerState.CurrentlyPlaying.Progress + seekIncrementMs
+		widget.client.Seek(position)
+	})
+}
+
+// seekBackward rewinds the current track by seekIncrementMs, never seeking before the
+// start of the track
+func (widget *Widget) seekBackward() {
+	widget.withActiveDevice(func() {
+		position := widget.playerState.CurrentlyPlaying.Progress - seekIncrementMs
+		if position < 0 {
+			position = 0
+		}
+		widget.client.Seek(position)
+	})
 }
@@ -18,6 +18,7 @@ type Settings struct {
 	callbackPort string
 	clientID     string
 	secretKey    string
+	showArt      bool
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -28,6 +29,7 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		callbackPort: ymlConfig.UString("callbackPort", "8080"),
 		clientID:     ymlConfig.UString("clientID", os.Getenv("SPOTIFY_ID")),
 		secretKey:    ymlConfig.UString("secretKey", os.Getenv("SPOTIFY_SECRET")),
+		showArt:      ymlConfig.UBool("showArt", false),
 	}
 
 	return &settings
@@ -26,6 +26,7 @@ type Info struct {
 	Artists     string
 	Title       string
 	Album       string
+	AlbumArtURL string
 	TrackNumber int
 	Status      string
 }
@@ -37,8 +38,10 @@ type Widget struct {
 
 	Info
 
+	albumArt    albumArt
 	client      *spotify.Client
 	clientChan  chan *spotify.Client
+	deviceError string
 	playerState *spotify.PlayerState
 	settings    *Settings
 }
@@ -143,6 +146,7 @@ func (w *Widget) refreshSpotifyInfos() error {
 	artists = artists[:len(artists)-2]
 	w.Info.Artists = artists
 	w.Info.Title = fmt.Sprint(w.playerState.CurrentlyPlaying.Item.Name)
+	w.Info.AlbumArtURL = smallestImageURL(w.playerState.CurrentlyPlaying.Item.Album.Images)
 	w.Info.TrackNumber = w.playerState.CurrentlyPlaying.Item.TrackNumber
 	if w.playerState.CurrentlyPlaying.Playing {
 		w.Info.Status = "Playing"
@@ -167,7 +171,14 @@ func (w *Widget) createOutput() (string, string, bool) {
 	if err != nil {
 		output = err.Error()
 	} else {
-		output := utils.CenterText(fmt.Sprintf("[green]Now %v [white]\n", w.Info.Status), w.CommonSettings().Width)
+		output := ""
+		if w.deviceError != "" {
+			output += utils.CenterText(fmt.Sprintf("[red]%v[white]\n", w.deviceError), w.CommonSettings().Width)
+		}
+		if w.settings.showArt {
+			output += w.renderAlbumArt(w.Info.AlbumArtURL)
+		}
+		output += utils.CenterText(fmt.Sprintf("[green]Now %v [white]\n", w.Info.Status), w.CommonSettings().Width)
 		output += utils.CenterText(fmt.Sprintf("[green]Title:[white] %v\n", w.Info.Title), w.CommonSettings().Width)
 		output += utils.CenterText(fmt.Sprintf("[green]Artist:[white] %v\n", w.Info.Artists), w.CommonSettings().Width)
 		output += utils.CenterText(fmt.Sprintf("[green]Album:[white] %v\n", w.Info.Album), w.CommonSettings().Width)
@@ -0,0 +1,55 @@
+package textfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// jsonlText renders contents as JSON Lines: each line is parsed as a JSON object and
+// rendered as "field=value" pairs for the configured fields, falling back to the raw line
+// if it fails to parse as JSON
+func jsonlText(contents string, fields []string) string {
+	lines := strings.Split(contents, "\n")
+
+	rendered := make([]string, len(lines))
+	for idx, line := range lines {
+		rendered[idx] = jsonlLine(line, fields)
+	}
+
+	return strings.Join(rendered, "\n")
+}
+
+// jsonlLine renders a single JSON Lines record as "field=value" pairs, in the order given
+// by fields. Fields missing from the record are left out; an empty line or a line that
+// isn't valid JSON is returned as-is
+func jsonlLine(line string, fields []string) string {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		return line
+	}
+
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(trimmed), &record); err != nil {
+		return line
+	}
+
+	if len(fields) == 0 {
+		for field := range record {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+	}
+
+	pairs := make([]string, 0, len(fields))
+	for _, field := range fields {
+		value, ok := record[field]
+		if !ok {
+			continue
+		}
+		pairs = append(pairs, fmt.Sprintf("[green]%s[white]=%v", field, value))
+	}
+
+	return strings.Join(pairs, " ")
+}
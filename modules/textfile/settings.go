@@ -3,6 +3,7 @@ package textfile
 import (
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
+	"github.com/wtfutil/wtf/utils"
 )
 
 const (
@@ -15,8 +16,12 @@ type Settings struct {
 	common *cfg.Common
 
 	filePaths   []interface{}
+	fields      []string
+	follow      bool
 	format      bool
 	formatStyle string
+	jsonl       bool
+	syntax      string
 	wrapText    bool
 }
 
@@ -27,8 +32,12 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 		common: cfg.NewCommonSettingsFromModule(name, defaultTitle, defaultFocusable, ymlConfig, globalConfig),
 
 		filePaths:   ymlConfig.UList("filePaths"),
+		fields:      utils.ToStrs(ymlConfig.UList("fields", []interface{}{})),
+		follow:      ymlConfig.UBool("follow", false),
 		format:      ymlConfig.UBool("format", false),
 		formatStyle: ymlConfig.UString("formatStyle", "vim"),
+		jsonl:       ymlConfig.UBool("jsonl", false),
+		syntax:      ymlConfig.UString("syntax", ""),
 		wrapText:    ymlConfig.UBool("wrapText", true),
 	}
 
@@ -6,8 +6,10 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/alecthomas/chroma"
 	"github.com/alecthomas/chroma/formatters"
 	"github.com/alecthomas/chroma/lexers"
 	"github.com/alecthomas/chroma/styles"
@@ -19,6 +21,11 @@ import (
 
 const (
 	pollingIntervalms = 100
+
+	// highlightChunkBytes bounds how much of a file chroma tokenises in one pass. Files
+	// larger than this are highlighted a chunk at a time, on line boundaries, so a single
+	// huge file doesn't block the UI for the length of one giant tokenise/format call
+	highlightChunkBytes = 64 * 1024
 )
 
 type Widget struct {
@@ -26,7 +33,10 @@ type Widget struct {
 	view.MultiSourceWidget
 	view.TextWidget
 
-	settings *Settings
+	app         *tview.Application
+	settings    *Settings
+	tailOffsets map[string]int64
+	tailContent map[string]string
 }
 
 // NewWidget creates a new instance of a widget
@@ -36,7 +46,11 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		MultiSourceWidget: view.NewMultiSourceWidget(settings.common, "filePath", "filePaths"),
 		TextWidget:        view.NewTextWidget(app, settings.common),
 
+		app:      app,
 		settings: settings,
+
+		tailOffsets: make(map[string]int64),
+		tailContent: make(map[string]string),
 	}
 
 	// Don't use a timer for this widget, watch for filesystem changes instead
@@ -61,7 +75,21 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 // Refresh is only called once on start-up. Its job is to display the
 // text files that first time. After that, the watcher takes over
 func (widget *Widget) Refresh() {
-	widget.Redraw(widget.content)
+	if !widget.settings.follow {
+		widget.Redraw(widget.content)
+		return
+	}
+
+	// In follow mode, always scroll to the bottom after redrawing so newly
+	// appended lines stay in view
+	title, text, wrap := widget.content()
+
+	widget.app.QueueUpdateDraw(func() {
+		widget.View.SetWrap(wrap)
+		widget.View.SetTitle(widget.ContextualTitle(title))
+		widget.View.SetText(text)
+		widget.View.ScrollToEnd()
+	})
 }
 
 func (widget *Widget) HelpText() string {
@@ -80,7 +108,9 @@ func (widget *Widget) content() (string, string, bool) {
 	_, _, width, _ := widget.View.GetRect()
 	text := widget.settings.common.SigilStr(len(widget.Sources), widget.Idx, width) + "\n"
 
-	if widget.settings.format {
+	if widget.settings.jsonl {
+		text += jsonlText(widget.plainText(), widget.settings.fields)
+	} else if widget.settings.format {
 		text += widget.formattedText()
 	} else {
 		text += widget.plainText()
@@ -102,10 +132,7 @@ func (widget *Widget) formattedText() string {
 	}
 	defer file.Close()
 
-	lexer := lexers.Match(filePath)
-	if lexer == nil {
-		lexer = lexers.Fallback
-	}
+	lexer := widget.lexerFor(filePath)
 
 	style := styles.Get(widget.settings.formatStyle)
 	if style == nil {
@@ -117,15 +144,70 @@ func (widget *Widget) formattedText() string {
 	}
 
 	contents, _ := ioutil.ReadAll(file)
-	iterator, _ := lexer.Tokenise(nil, string(contents))
 
+	return widget.highlight(lexer, style, formatter, string(contents))
+}
+
+// lexerFor resolves the chroma lexer to use: an explicit `syntax` setting takes precedence,
+// falling back to matching the file's extension and finally to the plain-text fallback lexer
+func (widget *Widget) lexerFor(filePath string) chroma.Lexer {
+	if widget.settings.syntax != "" {
+		if lexer := lexers.Get(widget.settings.syntax); lexer != nil {
+			return lexer
+		}
+	}
+
+	if lexer := lexers.Match(filePath); lexer != nil {
+		return lexer
+	}
+
+	return lexers.Fallback
+}
+
+// highlight tokenises and formats contents, splitting it into highlightChunkBytes-sized,
+// line-aligned chunks for large files so no single chroma call has to process the whole file
+func (widget *Widget) highlight(lexer chroma.Lexer, style *chroma.Style, formatter chroma.Formatter, contents string) string {
 	var buf bytes.Buffer
-	formatter.Format(&buf, style, iterator)
+
+	for _, chunk := range splitIntoChunks(contents, highlightChunkBytes) {
+		iterator, _ := lexer.Tokenise(nil, chunk)
+		formatter.Format(&buf, style, iterator)
+	}
 
 	return tview.TranslateANSI(buf.String())
 }
 
+// splitIntoChunks breaks contents into pieces no larger than chunkBytes, only splitting on
+// line boundaries so multi-line lexer state (strings, comments, etc.) isn't cut mid-line
+func splitIntoChunks(contents string, chunkBytes int) []string {
+	if len(contents) <= chunkBytes {
+		return []string{contents}
+	}
+
+	chunks := []string{}
+	lines := strings.SplitAfter(contents, "\n")
+
+	chunk := strings.Builder{}
+	for _, line := range lines {
+		if chunk.Len() > 0 && chunk.Len()+len(line) > chunkBytes {
+			chunks = append(chunks, chunk.String())
+			chunk.Reset()
+		}
+		chunk.WriteString(line)
+	}
+
+	if chunk.Len() > 0 {
+		chunks = append(chunks, chunk.String())
+	}
+
+	return chunks
+}
+
 func (widget *Widget) plainText() string {
+	if widget.settings.follow {
+		return widget.tailText(widget.CurrentSource())
+	}
+
 	filePath, _ := utils.ExpandHomeDir(widget.CurrentSource())
 
 	text, err := ioutil.ReadFile(filePath)
@@ -135,6 +217,45 @@ func (widget *Widget) plainText() string {
 	return string(text)
 }
 
+// tailText returns the text that has been appended to source since it was last read, in the
+// style of `tail -f`. If the file has shrunk since the last read -- because it was truncated
+// or rotated out from under us -- the accumulated tail content is discarded and reread from
+// the start of the (new) file
+func (widget *Widget) tailText(source string) string {
+	filePath, _ := utils.ExpandHomeDir(source)
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err.Error()
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err.Error()
+	}
+
+	offset := widget.tailOffsets[source]
+	if info.Size() < offset {
+		offset = 0
+		widget.tailContent[source] = ""
+	}
+
+	if _, err := file.Seek(offset, os.SEEK_SET); err != nil {
+		return err.Error()
+	}
+
+	appended, err := ioutil.ReadAll(file)
+	if err != nil {
+		return err.Error()
+	}
+
+	widget.tailContent[source] += string(appended)
+	widget.tailOffsets[source] = offset + int64(len(appended))
+
+	return widget.tailContent[source]
+}
+
 func (widget *Widget) watchForFileChanges() {
 	watch := watcher.New()
 	watch.FilterOps(watcher.Write)
@@ -2,6 +2,7 @@ package todo
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/checklist"
@@ -13,51 +14,110 @@ func (widget *Widget) display() {
 }
 
 func (widget *Widget) content() (string, string, bool) {
+	selectedItem := widget.SelectedItem()
+
+	order := widget.displayOrder()
+	widget.visibleIdx = order
+	widget.ScrollableWidget.SetItemCount(len(order))
+
+	if row, ok := rowOf(order, widget.list, selectedItem); ok {
+		widget.Selected = row
+	}
+
 	str := ""
-	newList := checklist.NewChecklist(
-		widget.settings.common.Sigils.Checkbox.Checked,
-		widget.settings.common.Sigils.Checkbox.Unchecked,
-	)
+	maxLen := widget.list.LongestLine()
+	for row, idx := range order {
+		str += widget.formattedItemLine(row, idx, maxLen)
+	}
 
-	offset := 0
-	selectedItem := widget.SelectedItem()
-	for idx, item := range widget.list.UncheckedItems() {
-		str += widget.formattedItemLine(idx, item, selectedItem, widget.list.LongestLine())
-		newList.Items = append(newList.Items, item)
-		offset++
+	return widget.CommonSettings().Title, str, false
+}
+
+// displayOrder returns, as indexes into widget.list.Items, the rows to render. Nested lists
+// are rendered in file order (so parents stay adjacent to their children), skipping the
+// descendants of any collapsed parent. Flat lists keep the legacy behavior of showing
+// unchecked items first, then checked ones
+func (widget *Widget) displayOrder() []int {
+	if widget.list.HasNesting() {
+		return widget.list.VisibleIndexes()
 	}
 
-	for idx, item := range widget.list.CheckedItems() {
-		str += widget.formattedItemLine(idx+offset, item, selectedItem, widget.list.LongestLine())
-		newList.Items = append(newList.Items, item)
+	order := []int{}
+	for idx, item := range widget.list.Items {
+		if !item.Checked {
+			order = append(order, idx)
+		}
 	}
+	for idx, item := range widget.list.Items {
+		if item.Checked {
+			order = append(order, idx)
+		}
+	}
+
+	return order
+}
 
-	if idx, ok := newList.IndexByItem(selectedItem); ok {
-		widget.Selected = idx
+// rowOf returns the row at which item appears in order, given the backing list
+func rowOf(order []int, list checklist.Checklist, item *checklist.ChecklistItem) (int, bool) {
+	for row, idx := range order {
+		if list.Items[idx] == item {
+			return row, true
+		}
 	}
 
-	widget.SetList(newList)
+	return 0, false
+}
 
-	return widget.CommonSettings().Title, str, false
+// selectItem re-derives the display order from the current (already-mutated) list.Items and
+// moves the selection to wherever item now appears in it. Callers that reorder or remove
+// items directly in widget.list (rather than going through content()) should call this
+// right after the mutation, so widget.visibleIdx and widget.Selected never go stale
+// relative to list.Items in between.
+func (widget *Widget) selectItem(item *checklist.ChecklistItem) {
+	order := widget.displayOrder()
+	widget.visibleIdx = order
+
+	if row, ok := rowOf(order, widget.list, item); ok {
+		widget.Selected = row
+	}
 }
 
-func (widget *Widget) formattedItemLine(idx int, currItem *checklist.ChecklistItem, selectedItem *checklist.ChecklistItem, maxLen int) string {
-	rowColor := widget.RowColor(idx)
+func (widget *Widget) formattedItemLine(row int, idx int, maxLen int) string {
+	currItem := widget.list.Items[idx]
+
+	rowColor := widget.RowColor(row)
 
 	if currItem.Checked {
 		rowColor = widget.settings.common.Colors.CheckboxTheme.Checked
+	} else if currItem.IsOverdue() {
+		rowColor = "red"
+	} else if currItem.IsDueToday() {
+		rowColor = "yellow"
 	}
 
-	if widget.View.HasFocus() && (currItem == selectedItem) {
-		rowColor = widget.RowColor(idx)
+	if widget.View.HasFocus() && row == widget.Selected {
+		rowColor = widget.RowColor(row)
+	}
+
+	indent := strings.Repeat("  ", currItem.Depth())
+
+	collapsedMark := ""
+	if widget.list.HasChildren(idx) {
+		if currItem.Collapsed {
+			collapsedMark = "▸ "
+		} else {
+			collapsedMark = "▾ "
+		}
 	}
 
-	row := fmt.Sprintf(
-		` [%s]|%s| %s[white]`,
+	line := fmt.Sprintf(
+		` [%s]|%s| %s%s%s[white]`,
 		rowColor,
 		currItem.CheckMark(),
-		tview.Escape(currItem.Text),
+		indent,
+		collapsedMark,
+		tview.Escape(currItem.DisplayText()),
 	)
 
-	return utils.HighlightableHelper(widget.View, row, idx, len(currItem.Text))
+	return utils.HighlightableHelper(widget.View, line, row, maxLen)
 }
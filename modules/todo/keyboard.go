@@ -16,6 +16,7 @@ func (widget *Widget) initializeKeyboardControls() {
 	widget.SetKeyboardChar(" ", widget.toggleChecked, "Toggle checkmark")
 	widget.SetKeyboardChar("n", widget.newItem, "Create new item")
 	widget.SetKeyboardChar("o", widget.openFile, "Open file")
+	widget.SetKeyboardChar("z", widget.toggleCollapsed, "Collapse/expand sub-tasks")
 
 	widget.SetKeyboardKey(tcell.KeyDown, widget.Next, "Select next item")
 	widget.SetKeyboardKey(tcell.KeyUp, widget.Prev, "Select previous item")
@@ -28,30 +29,38 @@ func (widget *Widget) initializeKeyboardControls() {
 }
 
 func (widget *Widget) deleteSelected() {
-
-	if !widget.isItemSelected() {
+	idx, ok := widget.selectedListIndex()
+	if !ok {
 		return
 	}
 
-	widget.list.Delete(widget.Selected)
-	widget.ScrollableWidget.SetItemCount(len(widget.list.Items))
+	widget.list.Delete(idx)
+
+	// Re-derive visibleIdx and the item count right away, so Prev()'s wraparound uses the
+	// post-delete list instead of the stale, now out-of-range mapping from before the delete
+	widget.visibleIdx = widget.displayOrder()
+	widget.ScrollableWidget.SetItemCount(len(widget.visibleIdx))
 	widget.Prev()
+
 	widget.persist()
 	widget.display()
 }
 
 func (widget *Widget) demoteSelected() {
-	if !widget.isItemSelected() {
+	idx, ok := widget.selectedListIndex()
+	if !ok {
 		return
 	}
 
-	j := widget.Selected + 1
+	item := widget.list.Items[idx]
+
+	j := idx + 1
 	if j >= len(widget.list.Items) {
 		j = 0
 	}
 
-	widget.list.Swap(widget.Selected, j)
-	widget.Selected = j
+	widget.list.Swap(idx, j)
+	widget.selectItem(item)
 
 	widget.persist()
 	widget.display()
@@ -63,28 +72,49 @@ func (widget *Widget) openFile() {
 }
 
 func (widget *Widget) promoteSelected() {
-	if !widget.isItemSelected() {
+	idx, ok := widget.selectedListIndex()
+	if !ok {
 		return
 	}
 
-	k := widget.Selected - 1
+	item := widget.list.Items[idx]
+
+	k := idx - 1
 	if k < 0 {
 		k = len(widget.list.Items) - 1
 	}
 
-	widget.list.Swap(widget.Selected, k)
-	widget.Selected = k
+	widget.list.Swap(idx, k)
+	widget.selectItem(item)
+
 	widget.persist()
 	widget.display()
 }
 
 func (widget *Widget) toggleChecked() {
-	selectedItem := widget.SelectedItem()
-	if selectedItem == nil {
+	idx, ok := widget.selectedListIndex()
+	if !ok {
+		return
+	}
+
+	checked := !widget.list.Items[idx].Checked
+	widget.list.SetChecked(idx, checked, widget.settings.cascadeCheck)
+	widget.persist()
+	widget.display()
+}
+
+// toggleCollapsed hides or reveals the sub-tasks nested beneath the selected item
+func (widget *Widget) toggleCollapsed() {
+	idx, ok := widget.selectedListIndex()
+	if !ok {
+		return
+	}
+
+	if !widget.list.HasChildren(idx) {
 		return
 	}
 
-	selectedItem.Toggle()
+	widget.list.Items[idx].Collapsed = !widget.list.Items[idx].Collapsed
 	widget.persist()
 	widget.display()
 }
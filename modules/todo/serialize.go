@@ -0,0 +1,23 @@
+package todo
+
+// serializedItem is the JSON representation of a single checklist item, as exposed by
+// the dashboard server
+type serializedItem struct {
+	Text    string `json:"text"`
+	Checked bool   `json:"checked"`
+}
+
+// Serialize returns the widget's checklist items, for consumption by the dashboard
+// server
+func (widget *Widget) Serialize() interface{} {
+	items := make([]serializedItem, 0, len(widget.list.Items))
+
+	for _, item := range widget.list.Items {
+		items = append(items, serializedItem{
+			Text:    item.DisplayText(),
+			Checked: item.Checked,
+		})
+	}
+
+	return items
+}
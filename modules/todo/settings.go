@@ -13,9 +13,11 @@ const (
 type Settings struct {
 	common *cfg.Common
 
-	filePath  string
-	checked   string
-	unchecked string
+	filePath     string
+	checked      string
+	unchecked    string
+	sortByDue    bool
+	cascadeCheck bool
 }
 
 func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *config.Config) *Settings {
@@ -24,9 +26,11 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	settings := Settings{
 		common: common,
 
-		filePath:  ymlConfig.UString("filename"),
-		checked:   ymlConfig.UString("checkedIcon", common.Checkbox.Checked),
-		unchecked: ymlConfig.UString("uncheckedIcon", common.Checkbox.Unchecked),
+		filePath:     ymlConfig.UString("filename"),
+		checked:      ymlConfig.UString("checkedIcon", common.Checkbox.Checked),
+		unchecked:    ymlConfig.UString("uncheckedIcon", common.Checkbox.Unchecked),
+		sortByDue:    ymlConfig.UBool("sortByDue", false),
+		cascadeCheck: ymlConfig.UBool("cascadeCheck", false),
 	}
 
 	return &settings
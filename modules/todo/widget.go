@@ -30,6 +30,11 @@ type Widget struct {
 	list     checklist.Checklist
 	pages    *tview.Pages
 	settings *Settings
+
+	// visibleIdx maps each currently-rendered row to its index in list.Items, letting
+	// sub-tasks hidden under a collapsed parent be skipped without losing their place in
+	// the backing list
+	visibleIdx []int
 }
 
 // NewWidget creates a new instance of a widget
@@ -68,12 +73,12 @@ func (widget *Widget) HelpText() string {
 
 // SelectedItem returns the currently-selected checklist item or nil if no item is selected
 func (widget *Widget) SelectedItem() *checklist.ChecklistItem {
-	var selectedItem *checklist.ChecklistItem
-	if widget.isItemSelected() {
-		selectedItem = widget.list.Items[widget.Selected]
+	idx, ok := widget.selectedListIndex()
+	if !ok {
+		return nil
 	}
 
-	return selectedItem
+	return widget.list.Items[idx]
 }
 
 // Refresh updates the data for this widget and displays it onscreen
@@ -82,10 +87,6 @@ func (widget *Widget) Refresh() {
 	widget.display()
 }
 
-func (widget *Widget) SetList(list checklist.Checklist) {
-	widget.list = list
-}
-
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *Widget) init() {
@@ -97,7 +98,17 @@ func (widget *Widget) init() {
 
 // isItemSelected returns weather any item of the todo is selected or not
 func (widget *Widget) isItemSelected() bool {
-	return widget.Selected >= 0 && widget.Selected < len(widget.list.Items)
+	return widget.Selected >= 0 && widget.Selected < len(widget.visibleIdx)
+}
+
+// selectedListIndex returns the index into widget.list.Items of the currently-selected row,
+// since widget.Selected is a row number among only the currently-visible rows
+func (widget *Widget) selectedListIndex() (int, bool) {
+	if !widget.isItemSelected() {
+		return 0, false
+	}
+
+	return widget.visibleIdx[widget.Selected], true
 }
 
 // Loads the todo list from3 Yaml file
@@ -109,7 +120,10 @@ func (widget *Widget) load() {
 
 	yaml.Unmarshal(fileData, &widget.list)
 
-	widget.ScrollableWidget.SetItemCount(len(widget.list.Items))
+	if widget.settings.sortByDue {
+		widget.list.SortByDue()
+	}
+
 	widget.setItemChecks()
 }
 
@@ -120,7 +134,6 @@ func (widget *Widget) newItem() {
 		text := form.GetFormItem(0).(*tview.InputField).GetText()
 
 		widget.list.Add(false, text)
-		widget.SetItemCount(len(widget.list.Items))
 		widget.persist()
 		widget.pages.RemovePage("modal")
 		widget.app.SetFocus(widget.View)
@@ -39,9 +39,14 @@ func (widget *Widget) content() (string, string, bool) {
 		title = widget.buildTitle(cityData)
 		_, _, width, _ := widget.View.GetRect()
 		content = widget.settings.common.SigilStr(len(widget.Data), widget.Idx, width) + "\n"
-		content += widget.description(cityData) + "\n\n"
-		content += widget.temperatures(cityData) + "\n"
-		content += widget.sunInfo(cityData)
+
+		if widget.showHourly {
+			content += widget.hourlyContent()
+		} else {
+			content += widget.description(cityData) + "\n\n"
+			content += widget.temperatures(cityData) + "\n"
+			content += widget.sunInfo(cityData)
+		}
 	}
 
 	return title, content, setWrap
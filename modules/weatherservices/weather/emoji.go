@@ -36,11 +36,17 @@ var weatherEmoji = map[string]string{
 }
 
 func (widget *Widget) emojiFor(data *owm.CurrentWeatherData) string {
-	if len(data.Weather) == 0 {
+	return emojiForConditions(data.Weather)
+}
+
+// emojiForConditions looks up the glyph for the first of a list of weather conditions, as
+// returned by both the current-weather and forecast endpoints
+func emojiForConditions(conditions []owm.Weather) string {
+	if len(conditions) == 0 {
 		return ""
 	}
 
-	emoji := weatherEmoji[data.Weather[0].Description]
+	emoji := weatherEmoji[conditions[0].Description]
 	if emoji == "" {
 		emoji = weatherEmoji["default"]
 	}
@@ -0,0 +1,93 @@
+package weather
+
+import (
+	"fmt"
+
+	owm "github.com/briandowns/openweathermap"
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// hourlyForecastWindows is how many 3-hour OpenWeatherMap forecast steps make up the next
+// 12 hours, since the "5 day / 3 hour" API is the finest-grained forecast this provider offers
+const hourlyForecastWindows = 4
+
+// toggleHourly flips between the current-conditions view and the hourly forecast view
+func (widget *Widget) toggleHourly() {
+	widget.showHourly = !widget.showHourly
+	widget.display()
+}
+
+// hourlyForecast returns the next 12 hours of forecast data for the currently-selected
+// location, fetching it from the API at most once per refresh cycle
+func (widget *Widget) hourlyForecast() *owm.Forecast5WeatherData {
+	if forecast, ok := widget.forecastCache[widget.Idx]; ok {
+		return forecast
+	}
+
+	cityID, coord, ok := widget.forecastLocation(widget.Idx)
+	if !ok {
+		return nil
+	}
+
+	forecast, err := owm.NewForecast("5", widget.settings.tempUnit, widget.settings.language, widget.settings.apiKey)
+	if err != nil {
+		return nil
+	}
+
+	if coord != nil {
+		err = forecast.DailyByCoordinates(coord, hourlyForecastWindows)
+	} else {
+		err = forecast.DailyByID(cityID, hourlyForecastWindows)
+	}
+	if err != nil {
+		return nil
+	}
+
+	data, ok := forecast.ForecastWeatherJson.(*owm.Forecast5WeatherData)
+	if !ok {
+		return nil
+	}
+
+	widget.forecastCache[widget.Idx] = data
+
+	return data
+}
+
+// hourlyContent renders the next 12 hours of forecast data in columns, one per 3-hour step,
+// falling back to a friendly message if the provider has no hourly data for this location
+func (widget *Widget) hourlyContent() string {
+	forecast := widget.hourlyForecast()
+	if forecast == nil || len(forecast.List) == 0 {
+		return " Hourly forecast is unavailable for this location\n"
+	}
+
+	content := ""
+	for _, entry := range forecast.List {
+		content += fmt.Sprintf(
+			"%8s: %s  %4.1f° %s\n",
+			wtf.UnixTime(int64(entry.Dt)).Format("15:04"),
+			emojiForConditions(entry.Weather),
+			entry.Main.Temp,
+			widget.settings.tempUnit,
+		)
+	}
+
+	return content
+}
+
+// forecastLocation returns the city ID or coordinate for the location at the given Sources
+// index, matching the order Fetch() builds widget.Data in: city IDs first, then coords
+func (widget *Widget) forecastLocation(idx int) (int, *owm.Coordinates, bool) {
+	cityIDs := widget.cityIDs()
+
+	if idx < len(cityIDs) {
+		return cityIDs[idx], nil, true
+	}
+
+	coordIdx := idx - len(cityIDs)
+	if coordIdx < 0 || coordIdx >= len(widget.settings.coords) {
+		return 0, nil, false
+	}
+
+	return 0, &widget.settings.coords[coordIdx], true
+}
@@ -7,6 +7,7 @@ func (widget *Widget) initializeKeyboardControls() {
 
 	widget.SetKeyboardChar("h", widget.PrevSource, "Select previous city")
 	widget.SetKeyboardChar("l", widget.NextSource, "Select next city")
+	widget.SetKeyboardChar("f", widget.toggleHourly, "Toggle hourly forecast")
 
 	widget.SetKeyboardKey(tcell.KeyLeft, widget.PrevSource, "Select previous city")
 	widget.SetKeyboardKey(tcell.KeyRight, widget.NextSource, "Select next city")
@@ -3,6 +3,7 @@ package weather
 import (
 	"os"
 
+	owm "github.com/briandowns/openweathermap"
 	"github.com/olebedev/config"
 	"github.com/wtfutil/wtf/cfg"
 )
@@ -22,6 +23,7 @@ type Settings struct {
 
 	apiKey   string
 	cityIDs  []interface{}
+	coords   []owm.Coordinates
 	language string
 	tempUnit string
 }
@@ -37,6 +39,32 @@ func NewSettingsFromYAML(name string, ymlConfig *config.Config, globalConfig *co
 	}
 
 	settings.colors.current = ymlConfig.UString("colors.current", "green")
+	settings.coords = parseCoords(ymlConfig)
 
 	return &settings
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+// parseCoords reads a list of {lat, lon} entries under the "coords" key, letting users
+// track locations that don't have a convenient OpenWeatherMap city ID
+func parseCoords(ymlConfig *config.Config) []owm.Coordinates {
+	coords := []owm.Coordinates{}
+
+	for _, entry := range ymlConfig.UList("coords") {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		lat, latOk := m["lat"].(float64)
+		lon, lonOk := m["lon"].(float64)
+		if !latOk || !lonOk {
+			continue
+		}
+
+		coords = append(coords, owm.Coordinates{Latitude: lat, Longitude: lon})
+	}
+
+	return coords
+}
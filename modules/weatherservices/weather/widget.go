@@ -16,6 +16,9 @@ type Widget struct {
 	// APIKey   string
 	Data []*owm.CurrentWeatherData
 
+	forecastCache map[int]*owm.Forecast5WeatherData
+	showHourly    bool
+
 	pages    *tview.Pages
 	settings *Settings
 }
@@ -27,10 +30,16 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 		MultiSourceWidget: view.NewMultiSourceWidget(settings.common, "cityid", "cityids"),
 		TextWidget:        view.NewTextWidget(app, settings.common),
 
+		forecastCache: make(map[int]*owm.Forecast5WeatherData),
+
 		pages:    pages,
 		settings: settings,
 	}
 
+	for range settings.coords {
+		widget.Sources = append(widget.Sources, "")
+	}
+
 	widget.initializeKeyboardControls()
 	widget.View.SetInputCapture(widget.InputCapture)
 
@@ -45,7 +54,8 @@ func NewWidget(app *tview.Application, pages *tview.Pages, settings *Settings) *
 
 // Fetch retrieves OpenWeatherMap data from the OpenWeatherMap API.
 // It takes a list of OpenWeatherMap city IDs.
-// It returns a list of OpenWeatherMap CurrentWeatherData structs, one per valid city code.
+// It returns a list of OpenWeatherMap CurrentWeatherData structs, one per valid city code,
+// followed by one per configured lat/lon coordinate, in the same order as widget.Sources
 func (widget *Widget) Fetch(cityIDs []int) []*owm.CurrentWeatherData {
 	data := []*owm.CurrentWeatherData{}
 
@@ -56,6 +66,13 @@ func (widget *Widget) Fetch(cityIDs []int) []*owm.CurrentWeatherData {
 		}
 	}
 
+	for _, coord := range widget.settings.coords {
+		result, err := widget.currentWeatherAt(coord)
+		if err == nil {
+			data = append(data, result)
+		}
+	}
+
 	return data
 }
 
@@ -66,6 +83,8 @@ func (widget *Widget) Refresh() {
 		widget.Data = widget.Fetch(utils.ToInts(widget.settings.cityIDs))
 	}
 
+	widget.forecastCache = make(map[int]*owm.Forecast5WeatherData)
+
 	widget.display()
 }
 
@@ -87,6 +106,10 @@ func (widget *Widget) apiKeyValid() bool {
 	return true
 }
 
+func (widget *Widget) cityIDs() []int {
+	return utils.ToInts(widget.settings.cityIDs)
+}
+
 func (widget *Widget) currentData() *owm.CurrentWeatherData {
 	if len(widget.Data) == 0 {
 		return nil
@@ -116,3 +139,21 @@ func (widget *Widget) currentWeather(cityCode int) (*owm.CurrentWeatherData, err
 
 	return weather, nil
 }
+
+func (widget *Widget) currentWeatherAt(coord owm.Coordinates) (*owm.CurrentWeatherData, error) {
+	weather, err := owm.NewCurrent(
+		widget.settings.tempUnit,
+		widget.settings.language,
+		widget.settings.apiKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = weather.CurrentByCoordinates(&coord)
+	if err != nil {
+		return nil, err
+	}
+
+	return weather, nil
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/wtfutil/wtf/wtf"
+)
+
+// Server exposes each widget's current data as JSON over HTTP, for consumption by
+// external tooling. It listens on a TCP address, or, if addr is prefixed with
+// "unix://", a Unix domain socket
+type Server struct {
+	settings *Settings
+	widgets  []wtf.Wtfable
+	listener net.Listener
+}
+
+// NewServer creates a new Server which will, once started, serve JSON for the given
+// widgets
+func NewServer(settings *Settings, widgets []wtf.Wtfable) *Server {
+	return &Server{
+		settings: settings,
+		widgets:  widgets,
+	}
+}
+
+/* -------------------- Exported Functions -------------------- */
+
+// Start begins listening and serving requests in the background. It does nothing if the
+// server is not enabled in settings
+func (server *Server) Start() error {
+	if !server.settings.enabled {
+		return nil
+	}
+
+	network, addr := server.networkAndAddr()
+
+	listener, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+	server.listener = listener
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/widgets", server.handleWidgets)
+
+	go http.Serve(listener, mux)
+
+	return nil
+}
+
+// SetWidgets replaces the set of widgets the server reports on, used after a hot reload
+// recreates the dashboard's widgets
+func (server *Server) SetWidgets(widgets []wtf.Wtfable) {
+	server.widgets = widgets
+}
+
+// Stop closes the listener, if the server was ever started
+func (server *Server) Stop() {
+	if server.listener != nil {
+		server.listener.Close()
+	}
+}
+
+/* -------------------- Unexported Functions -------------------- */
+
+// networkAndAddr splits settings.addr into the net.Listen network and address to use,
+// treating a "unix://" prefix as a Unix domain socket path and anything else as a TCP
+// address
+func (server *Server) networkAndAddr() (string, string) {
+	if addr := strings.TrimPrefix(server.settings.addr, "unix://"); addr != server.settings.addr {
+		return "unix", addr
+	}
+
+	return "tcp", server.settings.addr
+}
+
+// handleWidgets responds with a JSON object mapping each widget's name to the result of
+// its Serialize() method. Widgets which don't implement wtf.Serializable are omitted
+func (server *Server) handleWidgets(w http.ResponseWriter, r *http.Request) {
+	data := make(map[string]interface{}, len(server.widgets))
+
+	for _, widget := range server.widgets {
+		serializable, ok := widget.(wtf.Serializable)
+		if !ok {
+			continue
+		}
+
+		data[widget.Name()] = serializable.Serialize()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(data)
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NetworkAndAddr_TCP(t *testing.T) {
+	server := &Server{settings: &Settings{addr: "127.0.0.1:4000"}}
+
+	network, addr := server.networkAndAddr()
+
+	assert.Equal(t, "tcp", network)
+	assert.Equal(t, "127.0.0.1:4000", addr)
+}
+
+func Test_NetworkAndAddr_Unix(t *testing.T) {
+	server := &Server{settings: &Settings{addr: "unix:///tmp/wtf.sock"}}
+
+	network, addr := server.networkAndAddr()
+
+	assert.Equal(t, "unix", network)
+	assert.Equal(t, "/tmp/wtf.sock", addr)
+}
+
+func Test_Start_NoopWhenDisabled(t *testing.T) {
+	server := NewServer(&Settings{enabled: false}, nil)
+
+	assert.Nil(t, server.Start())
+	assert.Nil(t, server.listener)
+}
@@ -0,0 +1,20 @@
+package server
+
+import "github.com/olebedev/config"
+
+const defaultAddr = "127.0.0.1:4000"
+
+// Settings holds the configuration for the optional dashboard data server, read from the
+// wtf.server block of the global config
+type Settings struct {
+	enabled bool
+	addr    string
+}
+
+// NewSettingsFromYAML creates a new Settings from the wtf.server block of globalConfig
+func NewSettingsFromYAML(globalConfig *config.Config) *Settings {
+	return &Settings{
+		enabled: globalConfig.UBool("wtf.server.enabled", false),
+		addr:    globalConfig.UString("wtf.server.addr", defaultAddr),
+	}
+}
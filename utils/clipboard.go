@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"runtime"
+)
+
+// CopyToClipboard copies text to the system clipboard by shelling out to the
+// platform's clipboard utility, the same way OpenFile shells out to open a file
+// or URL. Returns an error if no suitable utility is available
+func CopyToClipboard(text string) error {
+	cmd, err := clipboardCommand()
+	if err != nil {
+		return err
+	}
+
+	cmd.Stdin = bytes.NewBufferString(text)
+	return cmd.Run()
+}
+
+func clipboardCommand() (*exec.Cmd, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("pbcopy"), nil
+	case "windows":
+		return exec.Command("clip"), nil
+	case "linux":
+		if path, err := exec.LookPath("xclip"); err == nil {
+			return exec.Command(path, "-selection", "clipboard"), nil
+		}
+		if path, err := exec.LookPath("xsel"); err == nil {
+			return exec.Command(path, "--clipboard", "--input"), nil
+		}
+		return nil, errors.New("no clipboard utility found; install xclip or xsel")
+	default:
+		return nil, errors.New("no clipboard utility known for this platform")
+	}
+}
@@ -0,0 +1,15 @@
+package utils
+
+import "fmt"
+
+// AuthHintError wraps err with a hint to check the configured API credentials
+// when the underlying failure looks like an authentication or authorization
+// problem (HTTP 401/403). Any other error is returned unchanged
+func AuthHintError(err error, statusCode int) error {
+	switch statusCode {
+	case 401, 403:
+		return fmt.Errorf("%v (check your apiKey)", err)
+	default:
+		return err
+	}
+}
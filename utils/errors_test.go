@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func Test_AuthHintError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		expected   string
+	}{
+		{"401 gets a hint", 401, "boom (check your apiKey)"},
+		{"403 gets a hint", 403, "boom (check your apiKey)"},
+		{"500 is unchanged", 500, "boom"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AuthHintError(errors.New("boom"), tt.statusCode)
+			if err.Error() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, err.Error())
+			}
+		})
+	}
+}
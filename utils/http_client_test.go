@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+)
+
+func Test_NewProxiedHTTPClient(t *testing.T) {
+	t.Run("falls back to environment proxy settings", func(t *testing.T) {
+		client := NewProxiedHTTPClient("")
+		if client.Transport == nil {
+			t.Fatal("expected a transport to be set")
+		}
+	})
+
+	t.Run("honors an explicit proxy URL", func(t *testing.T) {
+		client := NewProxiedHTTPClient("http://proxy.example.com:8080")
+		transport, ok := client.Transport.(*http.Transport)
+		if !ok {
+			t.Fatal("expected an *http.Transport")
+		}
+
+		req, _ := http.NewRequest("GET", "https://api.digitalocean.com", nil)
+		proxyURL, err := transport.Proxy(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if proxyURL == nil || proxyURL.Host != "proxy.example.com:8080" {
+			t.Errorf("expected proxy host proxy.example.com:8080, got %v", proxyURL)
+		}
+	})
+}
+
+func Test_NewHTTPClient(t *testing.T) {
+	t.Run("skipVerify disables certificate verification", func(t *testing.T) {
+		client := NewHTTPClient("", "", true)
+		transport := client.Transport.(*http.Transport)
+
+		if !transport.TLSClientConfig.InsecureSkipVerify {
+			t.Error("expected InsecureSkipVerify to be true")
+		}
+	})
+
+	t.Run("defaults to verifying certificates", func(t *testing.T) {
+		client := NewHTTPClient("", "", false)
+		transport := client.Transport.(*http.Transport)
+
+		if transport.TLSClientConfig.InsecureSkipVerify {
+			t.Error("expected InsecureSkipVerify to be false")
+		}
+	})
+
+	t.Run("ignores an unreadable CA file", func(t *testing.T) {
+		client := NewHTTPClient("", "/nonexistent/ca.pem", false)
+		transport := client.Transport.(*http.Transport)
+
+		if transport.TLSClientConfig.RootCAs != nil {
+			t.Error("expected RootCAs to be left unset")
+		}
+	})
+}
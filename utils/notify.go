@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"errors"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Notify sends a desktop notification with the given title and message by shelling out
+// to the platform's notification utility, the same way CopyToClipboard shells out to the
+// platform's clipboard utility. Returns an error if no suitable utility is available.
+func Notify(title, message string) error {
+	cmd, err := notifyCommand(title, message)
+	if err != nil {
+		return err
+	}
+
+	return cmd.Run()
+}
+
+func notifyCommand(title, message string) (*exec.Cmd, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		script := `display notification "` + escapeAppleScriptString(message) + `" with title "` + escapeAppleScriptString(title) + `"`
+		return exec.Command("osascript", "-e", script), nil
+	case "linux":
+		if path, err := exec.LookPath("notify-send"); err == nil {
+			return exec.Command(path, title, message), nil
+		}
+		return nil, errors.New("no notification utility found; install notify-send")
+	default:
+		return nil, errors.New("no notification utility known for this platform")
+	}
+}
+
+// escapeAppleScriptString escapes backslashes and double quotes so a value can be safely
+// embedded inside a double-quoted AppleScript string literal
+func escapeAppleScriptString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return strings.ReplaceAll(value, `"`, `\"`)
+}
@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// RetryWithJitter calls fn, retrying up to attempts additional times with jittered
+// exponential backoff whenever fn returns a non-nil error. This smooths over transient
+// failures (DNS hiccups, connection resets) that would otherwise surface immediately.
+// It stops early and returns if ctx is done. The error from the final attempt is
+// returned if fn never succeeds
+func RetryWithJitter(ctx context.Context, attempts int, baseDelay time.Duration, fn func() error) error {
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		err = fn()
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= attempts {
+			return err
+		}
+
+		delay := baseDelay * (1 << uint(attempt))
+		jitter := time.Duration(rand.Int63n(int64(delay)))
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay + jitter):
+		}
+	}
+}
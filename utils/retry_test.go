@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func Test_RetryWithJitter(t *testing.T) {
+	t.Run("succeeds without retrying", func(t *testing.T) {
+		calls := 0
+		err := RetryWithJitter(context.Background(), 3, time.Millisecond, func() error {
+			calls++
+			return nil
+		})
+
+		if err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("retries until success", func(t *testing.T) {
+		calls := 0
+		err := RetryWithJitter(context.Background(), 3, time.Millisecond, func() error {
+			calls++
+			if calls < 3 {
+				return errors.New("transient")
+			}
+			return nil
+		})
+
+		if err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("gives up after attempts exhausted", func(t *testing.T) {
+		calls := 0
+		err := RetryWithJitter(context.Background(), 2, time.Millisecond, func() error {
+			calls++
+			return errors.New("boom")
+		})
+
+		if err == nil {
+			t.Error("expected an error")
+		}
+		if calls != 3 {
+			t.Errorf("expected 3 calls (1 + 2 retries), got %d", calls)
+		}
+	})
+
+	t.Run("stops early when context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		calls := 0
+		err := RetryWithJitter(ctx, 3, time.Millisecond, func() error {
+			calls++
+			return errors.New("boom")
+		})
+
+		if err == nil {
+			t.Error("expected an error")
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call before ctx cancellation stopped retries, got %d", calls)
+		}
+	})
+}
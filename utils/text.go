@@ -8,19 +8,83 @@ import (
 )
 
 // CenterText takes a string and a width and pads the left and right of the string with
-// empty spaces to ensure that the string is in the middle of the returned value
+// empty spaces to ensure that the string is in the middle of the returned value. str's width
+// is measured with tview.TaggedStringWidth, so color tags embedded in str don't throw off
+// the centering.
 //
 // Example:
 //
-//    x := CenterText("cat", 11)
-//    > "    cat    "
-//
+//	x := CenterText("cat", 11)
+//	> "    cat    "
 func CenterText(str string, width int) string {
 	if width < 0 {
 		width = 0
 	}
 
-	return fmt.Sprintf("%[1]*s", -width, fmt.Sprintf("%[1]*s", (width+len(str))/2, str))
+	return alignColumn(str, width, tview.AlignCenter)
+}
+
+// Columns renders rows of cells into evenly-padded columns, so they visually line up without
+// a bordered grid. Each column is padded to the width of its widest cell, measured with
+// tview.TaggedStringWidth so color tags embedded in a cell don't throw off the alignment of
+// plain cells in the same column. alignments gives each column's tview.AlignLeft/AlignCenter/
+// AlignRight; a row with more cells than alignments has its extra columns left-aligned.
+// Columns are separated by two spaces, and trailing padding on the last column is trimmed.
+//
+// Example:
+//
+//	utils.Columns([][]string{{"name", "[green]status[white]"}}, []int{tview.AlignLeft, tview.AlignLeft})
+//	> []string{"name  [green]status[white]"}
+func Columns(rows [][]string, alignments []int) []string {
+	colCount := 0
+	for _, row := range rows {
+		if len(row) > colCount {
+			colCount = len(row)
+		}
+	}
+
+	widths := make([]int, colCount)
+	for _, row := range rows {
+		for col, cell := range row {
+			if w := tview.TaggedStringWidth(cell); w > widths[col] {
+				widths[col] = w
+			}
+		}
+	}
+
+	lines := make([]string, len(rows))
+	for rowIdx, row := range rows {
+		cells := make([]string, len(row))
+		for col, cell := range row {
+			align := tview.AlignLeft
+			if col < len(alignments) {
+				align = alignments[col]
+			}
+			cells[col] = alignColumn(cell, widths[col], align)
+		}
+		lines[rowIdx] = strings.TrimRight(strings.Join(cells, "  "), " ")
+	}
+
+	return lines
+}
+
+// alignColumn pads cell with spaces to width, honoring tview.AlignLeft/AlignCenter/
+// AlignRight, ignoring color tags when measuring the cell's visible width
+func alignColumn(cell string, width int, align int) string {
+	pad := width - tview.TaggedStringWidth(cell)
+	if pad < 0 {
+		pad = 0
+	}
+
+	switch align {
+	case tview.AlignRight:
+		return strings.Repeat(" ", pad) + cell
+	case tview.AlignCenter:
+		left := pad / 2
+		return strings.Repeat(" ", left) + cell + strings.Repeat(" ", pad-left)
+	default:
+		return cell + strings.Repeat(" ", pad)
+	}
 }
 
 // HighlightableHelper pads the given text with blank spaces to the width of the view
@@ -13,6 +13,36 @@ func Test_CenterText(t *testing.T) {
 	assert.Equal(t, "   cat   ", CenterText("cat", 9))
 }
 
+func Test_CenterText_colorTags(t *testing.T) {
+	assert.Equal(t, "   [red]cat[white]   ", CenterText("[red]cat[white]", 9))
+}
+
+func Test_Columns(t *testing.T) {
+	rows := [][]string{
+		{"name", "[green]status[white]"},
+		{"a-much-longer-name", "ok"},
+	}
+
+	actual := Columns(rows, []int{tview.AlignLeft, tview.AlignLeft})
+
+	assert.Equal(t, []string{
+		"name                [green]status[white]",
+		"a-much-longer-name  ok",
+	}, actual)
+}
+
+func Test_Columns_alignRight(t *testing.T) {
+	rows := [][]string{
+		{"1"},
+		{"22"},
+		{"333"},
+	}
+
+	actual := Columns(rows, []int{tview.AlignRight})
+
+	assert.Equal(t, []string{"  1", " 22", "333"}, actual)
+}
+
 func Test_HighlightableHelper(t *testing.T) {
 	view := tview.NewTextView()
 	actual := HighlightableHelper(view, "cats", 0, 5)
@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
+// RelativeTime parses an RFC3339 timestamp and returns a short, human-readable relative
+// time like "3 days ago" or "2 hours ago". If value isn't a valid RFC3339 timestamp, it's
+// returned unchanged, so callers can pass it straight through without checking first.
+func RelativeTime(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+
+	return humanize.Time(t)
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RelativeTime(t *testing.T) {
+	twoHoursAgo := time.Now().Add(-2 * time.Hour).Format(time.RFC3339)
+
+	actual := RelativeTime(twoHoursAgo)
+
+	assert.True(t, strings.HasSuffix(actual, "ago"), "expected a relative time ending in \"ago\", got %q", actual)
+}
+
+func Test_RelativeTime_invalid(t *testing.T) {
+	assert.Equal(t, "not a timestamp", RelativeTime("not a timestamp"))
+	assert.Equal(t, "", RelativeTime(""))
+}
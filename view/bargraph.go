@@ -10,7 +10,7 @@ import (
 	"github.com/wtfutil/wtf/wtf"
 )
 
-//BarGraph defines the data required to make a bar graph
+// BarGraph defines the data required to make a bar graph
 type BarGraph struct {
 	maxStars int
 	starChar string
@@ -36,7 +36,7 @@ func NewBarGraph(app *tview.Application, name string, commonSettings *cfg.Common
 		starChar: commonSettings.Config.UString("graphIcon", "|"),
 	}
 
-	widget.View = widget.createView(widget.bordered)
+	widget.View = widget.createView(widget.Bordered())
 
 	return widget
 }
@@ -46,10 +46,15 @@ func NewBarGraph(app *tview.Application, name string, commonSettings *cfg.Common
 // BuildBars will build a string of * to represent your data of [time][value]
 // time should be passed as a int64
 func (widget *BarGraph) BuildBars(data []Bar) {
+	if widget.Collapsed() {
+		widget.View.SetText("")
+		return
+	}
+
 	widget.View.SetText(BuildStars(data, widget.maxStars, widget.starChar))
 }
 
-//BuildStars build the string to display
+// BuildStars build the string to display
 func BuildStars(data []Bar, maxStars int, starChar string) string {
 	var buffer bytes.Buffer
 
@@ -102,6 +107,19 @@ func (widget *BarGraph) TextView() *tview.TextView {
 	return widget.View
 }
 
+// TickCountdown re-renders this widget's title so its countdown to the next refresh ticks
+// down once a second. Bar graphs render their body directly into View rather than through
+// Redraw, so only the title is refreshed here.
+func (widget *BarGraph) TickCountdown() {
+	if !widget.commonSettings.ShowCountdown {
+		return
+	}
+
+	widget.app.QueueUpdateDraw(func() {
+		widget.View.SetTitle(widget.ContextualTitle(widget.CommonSettings().Title))
+	})
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
 func (widget *BarGraph) createView(bordered bool) *tview.TextView {
@@ -3,23 +3,28 @@ package view
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/utils"
+	"github.com/wtfutil/wtf/wtf"
 )
 
 type Base struct {
 	app             *tview.Application
 	bordered        bool
+	collapsed       bool
 	commonSettings  *cfg.Common
 	enabled         bool
 	focusChar       string
 	focusable       bool
 	name            string
+	nextRefresh     time.Time
 	quitChan        chan bool
 	refreshing      bool
 	refreshInterval int
+	refreshJitter   int
 	enabledMutex    *sync.Mutex
 }
 
@@ -34,6 +39,7 @@ func NewBase(app *tview.Application, commonSettings *cfg.Common) Base {
 		name:            commonSettings.Name,
 		quitChan:        make(chan bool),
 		refreshInterval: commonSettings.RefreshInterval,
+		refreshJitter:   commonSettings.RefreshJitter,
 		refreshing:      false,
 		enabledMutex:    &sync.Mutex{},
 	}
@@ -42,11 +48,26 @@ func NewBase(app *tview.Application, commonSettings *cfg.Common) Base {
 
 /* -------------------- Exported Functions -------------------- */
 
-// Bordered returns whether or not this widget should be drawn with a border
+// Bordered returns whether or not this widget should be drawn with a border. Compact
+// mode always hides borders, regardless of this widget's own configuration.
 func (base *Base) Bordered() bool {
+	if wtf.IsCompact() {
+		return false
+	}
+
 	return base.bordered
 }
 
+// Collapsed returns true if this widget is currently collapsed down to just its title
+func (base *Base) Collapsed() bool {
+	return base.collapsed
+}
+
+// ToggleCollapsed flips this widget's collapsed state
+func (base *Base) ToggleCollapsed() {
+	base.collapsed = !base.collapsed
+}
+
 // BorderColor returns the color that the border of this widget should be drawn in
 func (base *Base) BorderColor() string {
 	if base.Focusable() {
@@ -65,6 +86,20 @@ func (base *Base) ConfigText() string {
 }
 
 func (base *Base) ContextualTitle(defaultStr string) string {
+	if wtf.IsCompact() {
+		return base.compactTitle(defaultStr)
+	}
+
+	if wtf.IsPaused() && defaultStr != "" {
+		defaultStr = fmt.Sprintf("%s %s", base.commonSettings.Sigils.Paused, defaultStr)
+	}
+
+	if defaultStr != "" {
+		if countdown := base.countdownText(); countdown != "" {
+			defaultStr = fmt.Sprintf("%s %s", defaultStr, countdown)
+		}
+	}
+
 	if defaultStr == "" && base.FocusChar() == "" {
 		return ""
 	} else if defaultStr != "" && base.FocusChar() == "" {
@@ -111,6 +146,18 @@ func (base *Base) HelpText() string {
 	return fmt.Sprintf("\n  There is no help available for widget %s", base.commonSettings.Module.Type)
 }
 
+// NextRefresh returns the time at which the scheduler expects to next call Refresh() on
+// this widget. It is the zero time if no refresh has been scheduled yet
+func (base *Base) NextRefresh() time.Time {
+	return base.nextRefresh
+}
+
+// SetNextRefresh records when the scheduler expects to next call Refresh() on this
+// widget, so ContextualTitle can render a countdown to it when ShowCountdown is enabled
+func (base *Base) SetNextRefresh(at time.Time) {
+	base.nextRefresh = at
+}
+
 func (base *Base) Name() string {
 	return base.name
 }
@@ -124,11 +171,24 @@ func (base *Base) Refreshing() bool {
 	return base.refreshing
 }
 
+// SetRefreshing marks whether this widget currently has a fetch in flight. The scheduler
+// sets this around every call to Refresh(), so any widget's content() can render a
+// loading indicator by checking Refreshing() without having to track the flag itself
+func (base *Base) SetRefreshing(value bool) {
+	base.refreshing = value
+}
+
 // RefreshInterval returns how often, in seconds, the base will return its data
 func (base *Base) RefreshInterval() int {
 	return base.refreshInterval
 }
 
+// RefreshJitter returns the maximum number of seconds the base's first refresh may
+// be randomly delayed by
+func (base *Base) RefreshJitter() int {
+	return base.refreshJitter
+}
+
 func (base *Base) SetFocusChar(char string) {
 	base.focusChar = char
 }
@@ -143,3 +203,31 @@ func (base *Base) Stop() {
 func (base *Base) String() string {
 	return base.name
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+// compactTitle renders a single-character abbreviation of defaultStr, so a widget's
+// border-less title still takes only minimal horizontal space in compact mode
+func (base *Base) compactTitle(defaultStr string) string {
+	if defaultStr == "" {
+		return ""
+	}
+
+	runes := []rune(defaultStr)
+	return fmt.Sprintf(" %c ", runes[0])
+}
+
+// countdownText returns a short "(Ns)" string showing how long until this widget's next
+// scheduled refresh, or "" if ShowCountdown is off or no refresh has been scheduled yet
+func (base *Base) countdownText() string {
+	if !base.commonSettings.ShowCountdown || base.nextRefresh.IsZero() {
+		return ""
+	}
+
+	remaining := time.Until(base.nextRefresh).Round(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return fmt.Sprintf("[darkgray](%ds)[white]", int(remaining.Seconds()))
+}
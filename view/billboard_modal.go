@@ -1,18 +1,24 @@
 package view
 
 import (
+	"strings"
+
 	"github.com/gdamore/tcell"
 	"github.com/rivo/tview"
 )
 
 const offscreen = -1000
-const modalWidth = 80
 const modalHeight = 22
+const modalWidthPercent = 0.8
 
 // NewBillboardModal creates and returns a modal dialog suitable for displaying
 // a wall of text
 // An example of this is the keyboard help modal that shows up for all widgets
 // that support keyboard control when '/' is pressed
+// The text scrolls with the arrow keys and PgUp/PgDn when it's taller than the
+// modal, while the "Esc to close" hint stays pinned to the bottom of the frame
+// The modal's width adapts to the terminal size: it's wide enough to fit text's
+// longest line, but never wider than modalWidthPercent of the screen
 func NewBillboardModal(text string, closeFunc func()) *tview.Frame {
 	keyboardIntercept := func(event *tcell.EventKey) *tcell.EventKey {
 		if string(event.Rune()) == "/" {
@@ -33,15 +39,23 @@ func NewBillboardModal(text string, closeFunc func()) *tview.Frame {
 
 	textView := tview.NewTextView()
 	textView.SetDynamicColors(true)
+	textView.SetScrollable(true)
 	textView.SetInputCapture(keyboardIntercept)
 	textView.SetText(text)
 	textView.SetWrap(true)
 
 	frame := tview.NewFrame(textView)
-	frame.SetRect(offscreen, offscreen, modalWidth, modalHeight)
+	frame.SetRect(offscreen, offscreen, contentWidth(text), modalHeight)
+	frame.AddText("Esc to close", false, tview.AlignCenter, tcell.ColorWhite)
 
 	drawFunc := func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
 		w, h := screen.Size()
+
+		width = contentWidth(text)
+		if maxWidth := int(float64(w) * modalWidthPercent); width > maxWidth {
+			width = maxWidth
+		}
+
 		frame.SetRect((w/2)-(width/2), (h/2)-(height/2), width, height)
 		return x, y, width, height
 	}
@@ -52,3 +66,19 @@ func NewBillboardModal(text string, closeFunc func()) *tview.Frame {
 
 	return frame
 }
+
+/* -------------------- Unexported Functions -------------------- */
+
+// contentWidth returns the width needed to fit the longest line of text, plus
+// room for the frame's border and padding
+func contentWidth(text string) int {
+	longest := 0
+
+	for _, line := range strings.Split(text, "\n") {
+		if w := tview.TaggedStringWidth(line); w > longest {
+			longest = w
+		}
+	}
+
+	return longest + 4
+}
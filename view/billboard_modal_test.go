@@ -0,0 +1,13 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ContentWidth(t *testing.T) {
+	assert.Equal(t, 7, contentWidth("cat"))
+	assert.Equal(t, 9, contentWidth("cat\nhello"))
+	assert.Equal(t, 9, contentWidth("[green]cat[white]\nhello"))
+}
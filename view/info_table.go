@@ -46,6 +46,11 @@ func NewInfoTable(headers []string, dataMap map[string]string, colWidth0, colWid
 	tbl.tblWriter.SetColMinWidth(0, colWidth0)
 	tbl.tblWriter.SetColMinWidth(1, colWidth1)
 
+	// Word-wrap values wider than colWidth1 onto additional lines within the same row,
+	// rather than letting them overflow the table
+	tbl.tblWriter.SetAutoWrapText(true)
+	tbl.tblWriter.SetColWidth(colWidth1)
+
 	keys := []string{}
 	for key := range dataMap {
 		keys = append(keys, key)
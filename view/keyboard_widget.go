@@ -2,6 +2,7 @@ package view
 
 import (
 	"fmt"
+	"log"
 	"strings"
 
 	"github.com/gdamore/tcell"
@@ -43,30 +44,69 @@ func NewKeyboardWidget(app *tview.Application, pages *tview.Pages, settings *cfg
 	return keyWidget
 }
 
-// SetKeyboardChar sets a character/function combination that responds to key presses
+// SetKeyboardChar sets a character/function combination that responds to key presses.
+// If the widget's `keys` setting remaps char to a different character, the remapped
+// character is bound instead and char falls out of use.
 // Example:
 //
-//    widget.SetKeyboardChar("d", widget.deleteSelectedItem)
-//
+//	widget.SetKeyboardChar("d", widget.deleteSelectedItem)
 func (widget *KeyboardWidget) SetKeyboardChar(char string, fn func(), helpText string) {
 	if char == "" {
 		return
 	}
 
+	boundChar := widget.remappedChar(char)
+
 	// Check to ensure that the key trying to be used isn't already being used for something
-	if _, ok := widget.charMap[char]; ok {
-		panic(fmt.Sprintf("Key is already mapped to a keyboard command: %s\n", char))
+	if _, ok := widget.charMap[boundChar]; ok {
+		log.Printf("[%s] Key is already mapped to a keyboard command, ignoring remap: %s\n", widget.settings.Module.Type, boundChar)
+		boundChar = char
+		if _, ok := widget.charMap[boundChar]; ok {
+			panic(fmt.Sprintf("Key is already mapped to a keyboard command: %s\n", boundChar))
+		}
 	}
 
-	widget.charMap[char] = fn
-	widget.charHelp = append(widget.charHelp, helpItem{char, helpText})
+	widget.charMap[boundChar] = fn
+	widget.charHelp = append(widget.charHelp, helpItem{boundChar, helpText})
 }
 
-// SetKeyboardKey sets a tcell.Key/function combination that responds to key presses
+// remappedChar returns the configured replacement for char from the widget's `keys`
+// setting, or char itself if no replacement is configured
+func (widget *KeyboardWidget) remappedChar(char string) string {
+	if widget.settings == nil {
+		return char
+	}
+
+	if replacement, ok := widget.settings.Keys[char]; ok && replacement != "" {
+		return replacement
+	}
+
+	return char
+}
+
+// SetPrimaryAction establishes the widget-wide convention that pressing Enter runs fn, the
+// widget's "open details" or other primary action on the currently-selected item. Listing
+// "enter" as a key in the widget's `keys` setting additionally binds that character to fn,
+// so users who'd rather not reach for Enter can remap it.
 // Example:
 //
-//    widget.SetKeyboardKey(tcell.KeyCtrlD, widget.deleteSelectedItem)
+//	widget.SetPrimaryAction(widget.showInfo, "Show info about the selected item")
+func (widget *KeyboardWidget) SetPrimaryAction(fn func(), helpText string) {
+	widget.SetKeyboardKey(tcell.KeyEnter, fn, helpText)
+
+	if widget.settings == nil {
+		return
+	}
+
+	if char, ok := widget.settings.Keys["enter"]; ok && char != "" {
+		widget.SetKeyboardChar(char, fn, helpText)
+	}
+}
+
+// SetKeyboardKey sets a tcell.Key/function combination that responds to key presses
+// Example:
 //
+//	widget.SetKeyboardKey(tcell.KeyCtrlD, widget.deleteSelectedItem)
 func (widget *KeyboardWidget) SetKeyboardKey(key tcell.Key, fn func(), helpText string) {
 	widget.keyMap[key] = fn
 	widget.keyHelp = append(widget.keyHelp, helpItem{tcell.KeyNames[key], helpText})
@@ -89,8 +129,7 @@ func (widget *KeyboardWidget) InitializeCommonControls(refreshFunc func()) {
 // InputCapture is the function passed to tview's SetInputCapture() function
 // This is done during the main widget's creation process using the following code:
 //
-//    widget.View.SetInputCapture(widget.InputCapture)
-//
+//	widget.View.SetInputCapture(widget.InputCapture)
 func (widget *KeyboardWidget) InputCapture(event *tcell.EventKey) *tcell.EventKey {
 	if event == nil {
 		return nil
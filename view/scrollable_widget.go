@@ -13,6 +13,12 @@ type ScrollableWidget struct {
 	Selected       int
 	maxItems       int
 	RenderFunction func()
+
+	filtering      bool
+	filterText     string
+	filterFunction func(idx int, filterText string) bool
+
+	pinnedKey interface{}
 }
 
 func NewScrollableWidget(app *tview.Application, commonSettings *cfg.Common) ScrollableWidget {
@@ -33,10 +39,52 @@ func (widget *ScrollableWidget) SetRenderFunction(displayFunc func()) {
 	widget.RenderFunction = displayFunc
 }
 
+// SetItemCount sets the number of items currently available to select among. The current
+// selection is preserved across calls as long as its index is still in range, so a widget's
+// selection survives a Refresh() instead of resetting to nothing every time
 func (widget *ScrollableWidget) SetItemCount(items int) {
 	widget.maxItems = items
+
 	if items == 0 {
 		widget.Selected = -1
+	} else if widget.Selected >= items {
+		widget.Selected = items - 1
+	}
+}
+
+// PinSelection remembers the identity of the currently-selected item, so that a later call
+// to SetItems can re-point the selection at it even if the item set was reordered or had
+// items removed ahead of it in between. keyFunc returns a stable, comparable identity (e.g.
+// a database ID) for the item at idx; call it here, before mutating the underlying data, so
+// it's evaluated against the item set as it stands right now
+func (widget *ScrollableWidget) PinSelection(keyFunc func(idx int) interface{}) {
+	widget.pinnedKey = nil
+
+	if widget.Selected >= 0 {
+		widget.pinnedKey = keyFunc(widget.Selected)
+	}
+}
+
+// SetItems sets the number of items currently available to select among, like
+// SetItemCount, but if PinSelection was called beforehand, it re-points the selection at
+// whichever index now holds that same item - according to keyFunc, evaluated against the
+// item set as it stands after the mutation - instead of leaving the selection at its old
+// raw index, which may now point at a different item entirely (e.g. after a re-sort)
+func (widget *ScrollableWidget) SetItems(items int, keyFunc func(idx int) interface{}) {
+	pinnedKey := widget.pinnedKey
+	widget.pinnedKey = nil
+
+	widget.SetItemCount(items)
+
+	if pinnedKey == nil || items == 0 {
+		return
+	}
+
+	for idx := 0; idx < items; idx++ {
+		if keyFunc(idx) == pinnedKey {
+			widget.Selected = idx
+			return
+		}
 	}
 }
 
@@ -53,27 +101,194 @@ func (widget *ScrollableWidget) RowColor(idx int) string {
 }
 
 func (widget *ScrollableWidget) Next() {
-	widget.Selected++
-	if widget.Selected >= widget.maxItems {
-		widget.Selected = 0
-	}
 	if widget.maxItems == 0 {
 		widget.Selected = -1
+		widget.RenderFunction()
+		return
 	}
+
+	for i := 0; i < widget.maxItems; i++ {
+		widget.Selected++
+		if widget.Selected >= widget.maxItems {
+			widget.Selected = 0
+		}
+		if widget.Matches(widget.Selected) {
+			break
+		}
+	}
+
 	widget.RenderFunction()
 }
 
+// NextPage jumps forward a page at a time, clamping to the last item instead of wrapping
+// around the way Next does. The page size is the view's own visible row count, so paging
+// jumps by however many rows are actually on screen rather than a fixed guess
+func (widget *ScrollableWidget) NextPage() {
+	if widget.maxItems == 0 {
+		widget.Selected = -1
+		widget.RenderFunction()
+		return
+	}
+
+	widget.SelectIndex(widget.Selected + widget.visibleRows())
+}
+
+// PrevPage jumps backward a page at a time, clamping to the first item instead of
+// wrapping around the way Prev does. See NextPage for how the page size is determined
+func (widget *ScrollableWidget) PrevPage() {
+	if widget.maxItems == 0 {
+		widget.Selected = -1
+		widget.RenderFunction()
+		return
+	}
+
+	widget.SelectIndex(widget.Selected - widget.visibleRows())
+}
+
+// visibleRows returns the number of rows currently visible in the view, for use as the
+// page size in NextPage/PrevPage. It falls back to 1 if the view hasn't been laid out yet
+func (widget *ScrollableWidget) visibleRows() int {
+	_, _, _, height := widget.View.GetInnerRect()
+	if height < 1 {
+		return 1
+	}
+
+	return height
+}
+
 func (widget *ScrollableWidget) Prev() {
-	widget.Selected--
-	if widget.Selected < 0 {
-		widget.Selected = widget.maxItems - 1
+	if widget.maxItems == 0 {
+		widget.Selected = -1
+		widget.RenderFunction()
+		return
+	}
+
+	for i := 0; i < widget.maxItems; i++ {
+		widget.Selected--
+		if widget.Selected < 0 {
+			widget.Selected = widget.maxItems - 1
+		}
+		if widget.Matches(widget.Selected) {
+			break
+		}
 	}
+
+	widget.RenderFunction()
+}
+
+/* -------------------- Filtering -------------------- */
+
+// SetFilterFunction sets the predicate used to determine whether the item at idx matches
+// the current filter text. Widgets that want search/filter support call this once, typically
+// alongside SetRenderFunction
+func (widget *ScrollableWidget) SetFilterFunction(fn func(idx int, filterText string) bool) {
+	widget.filterFunction = fn
+}
+
+// StartFilter enters filter-entry mode with an empty filter query; subsequent keystrokes
+// should be routed into AppendFilterRune/BackspaceFilter instead of the widget's normal
+// keyboard bindings for as long as IsFiltering() remains true
+func (widget *ScrollableWidget) StartFilter() {
+	widget.filtering = true
+	widget.filterText = ""
+}
+
+// StopFilter exits filter-entry mode and clears the filter query, restoring the full,
+// unfiltered item list
+func (widget *ScrollableWidget) StopFilter() {
+	widget.filtering = false
+	widget.filterText = ""
+	widget.RenderFunction()
+}
+
+// ConfirmFilter exits filter-entry mode, returning keystrokes to the widget's normal
+// bindings, but - unlike StopFilter - leaves the current filter query (and its narrowed
+// results) in effect
+func (widget *ScrollableWidget) ConfirmFilter() {
+	widget.filtering = false
+	widget.RenderFunction()
+}
+
+// IsFiltering returns true while the filter query is being actively typed, i.e. between a
+// StartFilter and the StopFilter/ConfirmFilter that ends it
+func (widget *ScrollableWidget) IsFiltering() bool {
+	return widget.filtering
+}
+
+// FilterText returns the current filter query, whether it's still being edited or was
+// already confirmed with ConfirmFilter
+func (widget *ScrollableWidget) FilterText() string {
+	return widget.filterText
+}
+
+// AppendFilterRune appends a rune to the filter query
+func (widget *ScrollableWidget) AppendFilterRune(r rune) {
+	widget.filterText += string(r)
+	widget.RenderFunction()
+}
+
+// BackspaceFilter removes the last rune from the filter query
+func (widget *ScrollableWidget) BackspaceFilter() {
+	runes := []rune(widget.filterText)
+	if len(runes) == 0 {
+		return
+	}
+
+	widget.filterText = string(runes[:len(runes)-1])
+	widget.RenderFunction()
+}
+
+// Matches returns true if the item at idx matches the current filter query. A confirmed
+// filter (ConfirmFilter) still applies here even though IsFiltering() has gone back to
+// false; only an empty query (no filter entered yet, or one cleared by StopFilter) or no
+// filter function set makes everything match
+func (widget *ScrollableWidget) Matches(idx int) bool {
+	if widget.filterText == "" || widget.filterFunction == nil {
+		return true
+	}
+
+	return widget.filterFunction(idx, widget.filterText)
+}
+
+// SelectIndex selects the item at idx directly, clamping to the valid range of items and,
+// like Next/Prev, skipping to the nearest matching item if idx itself is filtered out. This
+// is the primitive a mouse click handler would call with the row under the cursor; the
+// vendored version of tview in this module has no mouse event support to wire it up to, so
+// there's no click-to-select yet
+func (widget *ScrollableWidget) SelectIndex(idx int) {
 	if widget.maxItems == 0 {
 		widget.Selected = -1
+		widget.RenderFunction()
+		return
+	}
+
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= widget.maxItems {
+		idx = widget.maxItems - 1
 	}
+
+	widget.Selected = widget.nearestMatch(idx)
 	widget.RenderFunction()
 }
 
+// nearestMatch returns the closest index to idx that currently Matches(), checking idx
+// itself first and then alternating outward (idx+1, idx-1, idx+2, idx-2, ...) so it finds
+// whichever direction is closer. Returns idx unchanged if nothing in the item set matches.
+func (widget *ScrollableWidget) nearestMatch(idx int) int {
+	for offset := 0; offset < widget.maxItems; offset++ {
+		if forward := idx + offset; forward < widget.maxItems && widget.Matches(forward) {
+			return forward
+		}
+		if backward := idx - offset; offset > 0 && backward >= 0 && widget.Matches(backward) {
+			return backward
+		}
+	}
+
+	return idx
+}
+
 func (widget *ScrollableWidget) Unselect() {
 	widget.Selected = -1
 	if widget.RenderFunction != nil {
@@ -0,0 +1,176 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/rivo/tview"
+	"github.com/wtfutil/wtf/cfg"
+)
+
+func testScrollableWidget() ScrollableWidget {
+	widget := NewScrollableWidget(
+		tview.NewApplication(),
+		&cfg.Common{
+			Module: cfg.Module{
+				Name: "test widget",
+			},
+		},
+	)
+	widget.SetRenderFunction(func() {})
+	widget.SetItemCount(3)
+
+	return widget
+}
+
+func Test_Matches_withNoFilter(t *testing.T) {
+	widget := testScrollableWidget()
+
+	if !widget.Matches(1) {
+		t.Errorf("\nexpected: %t\n     got: %t", true, widget.Matches(1))
+	}
+}
+
+func Test_Matches_withActiveFilter(t *testing.T) {
+	widget := testScrollableWidget()
+	widget.SetFilterFunction(func(idx int, filterText string) bool {
+		return idx == 1
+	})
+
+	widget.StartFilter()
+	widget.AppendFilterRune('x')
+
+	if widget.Matches(0) {
+		t.Errorf("\nexpected: %t\n     got: %t", false, widget.Matches(0))
+	}
+
+	if !widget.Matches(1) {
+		t.Errorf("\nexpected: %t\n     got: %t", true, widget.Matches(1))
+	}
+}
+
+func Test_Next_skipsNonMatchingItems(t *testing.T) {
+	widget := testScrollableWidget()
+	widget.SetFilterFunction(func(idx int, filterText string) bool {
+		return idx == 2
+	})
+
+	widget.StartFilter()
+	widget.AppendFilterRune('x')
+	widget.Next()
+
+	if widget.Selected != 2 {
+		t.Errorf("\nexpected: %d\n     got: %d", 2, widget.Selected)
+	}
+}
+
+func Test_SetItems_withPinnedSelection(t *testing.T) {
+	widget := testScrollableWidget()
+
+	items := []string{"a", "b", "c"}
+	keyFunc := func(idx int) interface{} { return items[idx] }
+
+	widget.Selected = 2
+
+	widget.PinSelection(keyFunc)
+	items = []string{"c", "a", "b"}
+	widget.SetItems(len(items), keyFunc)
+
+	if widget.Selected != 0 {
+		t.Errorf("\nexpected: %d\n     got: %d", 0, widget.Selected)
+	}
+}
+
+func Test_SetItems_pinnedItemRemoved(t *testing.T) {
+	widget := testScrollableWidget()
+
+	items := []string{"a", "b", "c"}
+	keyFunc := func(idx int) interface{} { return items[idx] }
+
+	widget.Selected = 2
+
+	widget.PinSelection(keyFunc)
+	items = []string{"a", "b"}
+	widget.SetItems(len(items), keyFunc)
+
+	if widget.Selected != 1 {
+		t.Errorf("\nexpected: %d\n     got: %d", 1, widget.Selected)
+	}
+}
+
+func Test_NextPage_usesVisibleRowCount(t *testing.T) {
+	widget := testScrollableWidget()
+	widget.SetItemCount(10)
+	widget.View.SetRect(0, 0, 20, 5)
+
+	widget.Selected = 0
+	widget.NextPage()
+
+	if widget.Selected != 5 {
+		t.Errorf("\nexpected: %d\n     got: %d", 5, widget.Selected)
+	}
+}
+
+func Test_NextPage_fallsBackToOneRowWhenCollapsed(t *testing.T) {
+	widget := testScrollableWidget()
+	widget.SetItemCount(10)
+	widget.View.SetRect(0, 0, 20, 0)
+
+	widget.Selected = 0
+	widget.NextPage()
+
+	if widget.Selected != 1 {
+		t.Errorf("\nexpected: %d\n     got: %d", 1, widget.Selected)
+	}
+}
+
+func Test_ConfirmFilter_keepsFilterApplied(t *testing.T) {
+	widget := testScrollableWidget()
+	widget.SetFilterFunction(func(idx int, filterText string) bool {
+		return idx == 1
+	})
+
+	widget.StartFilter()
+	widget.AppendFilterRune('x')
+	widget.ConfirmFilter()
+
+	if widget.IsFiltering() {
+		t.Errorf("expected filter-entry mode to have ended")
+	}
+
+	if widget.Matches(0) {
+		t.Errorf("expected the confirmed filter to still narrow the results")
+	}
+	if !widget.Matches(1) {
+		t.Errorf("expected the confirmed filter's matching item to still match")
+	}
+}
+
+func Test_SelectIndex_skipsToNearestMatch(t *testing.T) {
+	widget := testScrollableWidget()
+	widget.SetItemCount(5)
+	widget.SetFilterFunction(func(idx int, filterText string) bool {
+		return idx == 1 || idx == 3
+	})
+
+	widget.StartFilter()
+	widget.AppendFilterRune('x')
+
+	widget.SelectIndex(2)
+
+	if widget.Selected != 1 && widget.Selected != 3 {
+		t.Errorf("expected selection to land on a matching index, got %d", widget.Selected)
+	}
+}
+
+func Test_BackspaceFilter(t *testing.T) {
+	widget := testScrollableWidget()
+
+	widget.StartFilter()
+	widget.AppendFilterRune('a')
+	widget.AppendFilterRune('b')
+	widget.BackspaceFilter()
+
+	if widget.FilterText() != "a" {
+		t.Errorf("\nexpected: %s\n     got: %s", "a", widget.FilterText())
+	}
+}
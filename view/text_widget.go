@@ -1,6 +1,9 @@
 package view
 
 import (
+	"fmt"
+	"log"
+
 	"github.com/rivo/tview"
 	"github.com/wtfutil/wtf/cfg"
 	"github.com/wtfutil/wtf/wtf"
@@ -10,6 +13,8 @@ import (
 type TextWidget struct {
 	Base
 	View *tview.TextView
+
+	lastData func() (string, string, bool)
 }
 
 // NewTextWidget creates and returns an instance of TextWidget
@@ -18,7 +23,7 @@ func NewTextWidget(app *tview.Application, commonSettings *cfg.Common) TextWidge
 		Base: NewBase(app, commonSettings),
 	}
 
-	widget.View = widget.createView(widget.bordered)
+	widget.View = widget.createView(widget.Bordered())
 
 	return widget
 }
@@ -30,8 +35,16 @@ func (widget *TextWidget) TextView() *tview.TextView {
 }
 
 func (widget *TextWidget) Redraw(data func() (string, string, bool)) {
+	widget.lastData = data
+
 	widget.app.QueueUpdateDraw(func() {
-		title, content, wrap := data()
+		title, content, wrap := widget.safeData(data)
+
+		// A collapsed widget shows only its title; its body is left empty until it's
+		// expanded again
+		if widget.Collapsed() {
+			content = ""
+		}
 
 		widget.View.Clear()
 		widget.View.SetWrap(wrap)
@@ -40,8 +53,35 @@ func (widget *TextWidget) Redraw(data func() (string, string, bool)) {
 	})
 }
 
+// TickCountdown re-renders this widget from the data it last displayed, so its title's
+// countdown to the next refresh ticks down once a second without waiting for that
+// refresh, or re-fetching any data, to actually happen. It is a no-op until the widget has
+// rendered at least once, or if ShowCountdown is off.
+func (widget *TextWidget) TickCountdown() {
+	if !widget.commonSettings.ShowCountdown || widget.lastData == nil {
+		return
+	}
+
+	widget.Redraw(widget.lastData)
+}
+
 /* -------------------- Unexported Functions -------------------- */
 
+// safeData calls data(), recovering from a panic so a bug in one widget's render logic
+// shows up as an error in that widget instead of crashing the whole app
+func (widget *TextWidget) safeData(data func() (string, string, bool)) (title, content string, wrap bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[%s] panic while rendering: %v", widget.Name(), r)
+			title = widget.commonSettings.Title
+			content = fmt.Sprintf("widget error: %v", r)
+			wrap = true
+		}
+	}()
+
+	return data()
+}
+
 func (widget *TextWidget) createView(bordered bool) *tview.TextView {
 	view := tview.NewTextView()
 
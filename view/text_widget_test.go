@@ -197,6 +197,35 @@ func Test_Name(t *testing.T) {
 	}
 }
 
+func Test_safeData(t *testing.T) {
+	txtWid := testTextWidget()
+
+	t.Run("when data does not panic", func(t *testing.T) {
+		title, content, wrap := txtWid.safeData(func() (string, string, bool) {
+			return "Title", "Content", true
+		})
+
+		if title != "Title" || content != "Content" || !wrap {
+			t.Errorf("\nexpected: Title, Content, true\n     got: %s, %s, %t", title, content, wrap)
+		}
+	})
+
+	t.Run("when data panics", func(t *testing.T) {
+		title, content, _ := txtWid.safeData(func() (string, string, bool) {
+			panic("boom")
+		})
+
+		if title != txtWid.commonSettings.Title {
+			t.Errorf("\nexpected title: %s\n          got: %s", txtWid.commonSettings.Title, title)
+		}
+
+		expectedContent := "widget error: boom"
+		if content != expectedContent {
+			t.Errorf("\nexpected: %s\n     got: %s", expectedContent, content)
+		}
+	})
+}
+
 func Test_String(t *testing.T) {
 	txtWid := testTextWidget()
 	actual := txtWid.String()
@@ -0,0 +1,9 @@
+package wtf
+
+// AlertStatusable is an optional interface a widget can implement to expose its current
+// status values, keyed by whatever identifies each one (e.g. a droplet name), for the
+// alerting system to evaluate a module's configured alertWhen rule against. Currently
+// "status" is the only field alertWhen can reference.
+type AlertStatusable interface {
+	AlertStatuses() map[string]string
+}
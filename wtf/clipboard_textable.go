@@ -0,0 +1,9 @@
+package wtf
+
+// ClipboardTextable is an optional interface a list widget can implement to expose its
+// currently-visible rows as tab-separated text, suitable for copying to the system
+// clipboard and pasting into a ticket or spreadsheet. Implementations should respect any
+// active filters and sorting, returning only what's actually currently displayed.
+type ClipboardTextable interface {
+	ClipboardText() string
+}
@@ -0,0 +1,26 @@
+package wtf
+
+import "sync"
+
+var (
+	compactMu sync.Mutex
+	compact   bool
+)
+
+// SetCompact sets the global compact-mode state. While compact, widgets are drawn
+// without borders and with titles shrunk to a single character, to reclaim space on
+// small terminals.
+func SetCompact(value bool) {
+	compactMu.Lock()
+	defer compactMu.Unlock()
+
+	compact = value
+}
+
+// IsCompact returns true if compact mode is currently globally enabled
+func IsCompact() bool {
+	compactMu.Lock()
+	defer compactMu.Unlock()
+
+	return compact
+}
@@ -0,0 +1,10 @@
+package wtf
+
+import "time"
+
+// NextRefreshable is an optional interface a widget can implement to record when its
+// next scheduled refresh will happen, so it can render a countdown to it. The scheduler
+// calls SetNextRefresh each time it schedules a widget's next timer tick.
+type NextRefreshable interface {
+	SetNextRefresh(time.Time)
+}
@@ -0,0 +1,26 @@
+package wtf
+
+import "sync"
+
+var (
+	pauseMu sync.Mutex
+	paused  bool
+)
+
+// SetPaused sets the global auto-refresh pause state. While paused, widgets should not
+// refresh on their own schedule; a widget's Refresh() triggered directly by a user action
+// is unaffected.
+func SetPaused(value bool) {
+	pauseMu.Lock()
+	defer pauseMu.Unlock()
+
+	paused = value
+}
+
+// IsPaused returns true if auto-refresh is currently globally paused
+func IsPaused() bool {
+	pauseMu.Lock()
+	defer pauseMu.Unlock()
+
+	return paused
+}
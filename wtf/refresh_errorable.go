@@ -0,0 +1,9 @@
+package wtf
+
+// RefreshErrorable is an optional interface a widget can implement to report whether its
+// most recent Refresh() failed. When a widget implements it, the scheduler backs off its
+// effective refresh interval on consecutive failures, rather than retrying at the normal
+// interval while an API stays down.
+type RefreshErrorable interface {
+	RefreshError() error
+}
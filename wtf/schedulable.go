@@ -5,4 +5,6 @@ type Schedulable interface {
 	Refresh()
 	Refreshing() bool
 	RefreshInterval() int
+	RefreshJitter() int
+	SetRefreshing(bool)
 }
@@ -0,0 +1,9 @@
+package wtf
+
+// Serializable is implemented by widgets that can export their current data as JSON,
+// for consumption by the optional dashboard server. It's deliberately not part of
+// Wtfable and is instead checked for with a type assertion, since most widgets have no
+// meaningful structured data to export beyond what's already rendered onscreen
+type Serializable interface {
+	Serialize() interface{}
+}
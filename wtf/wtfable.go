@@ -12,7 +12,9 @@ type Wtfable interface {
 	Schedulable
 	Stoppable
 
+	Bordered() bool
 	BorderColor() string
+	Collapsed() bool
 	ConfigText() string
 	FocusChar() string
 	Focusable() bool
@@ -21,6 +23,8 @@ type Wtfable interface {
 	Name() string
 	SetFocusChar(string)
 	TextView() *tview.TextView
+	TickCountdown()
+	ToggleCollapsed()
 
 	CommonSettings() *cfg.Common
 }